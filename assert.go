@@ -1,91 +1,511 @@
 package pocket
 
 import (
+	"cmp"
 	"errors"
+	"fmt"
 	"reflect"
+	"runtime"
 	"strings"
 	"testing"
+	"time"
 )
 
+// All assertions report whether they passed and accept optional msgAndArgs to add context to failure messages.
+// A single value is printed as is, a format string followed by arguments is passed to fmt.Sprintf.
+//
+// Example:
+//
+//	for i, tt := range tests {
+//		AssertEqual(t, got, tt.want, "case %d: %s", i, tt.name)
+//	}
+
 // AssertNotNil asserts that the given value is not nil.
-func AssertNotNil(t *testing.T, got any) {
+func AssertNotNil(t *testing.T, got any, msgAndArgs ...any) bool {
 	t.Helper()
 	if isNil(got) {
-		t.Errorf("expected non-nil, got nil")
+		fail(t, msgAndArgs, "expected non-nil, got nil")
+		return false
 	}
+	return pass(t)
 }
 
 // AssertNil asserts that the given value is nil.
-func AssertNil(t *testing.T, got any) {
+func AssertNil(t *testing.T, got any, msgAndArgs ...any) bool {
 	t.Helper()
 	if !isNil(got) {
-		t.Errorf("expected nil, got %v", got)
+		fail(t, msgAndArgs, "expected nil, got %v", got)
+		return false
 	}
+	return pass(t)
 }
 
 // AssertTrue asserts that the given value is true.
-func AssertTrue(t *testing.T, got bool) {
+func AssertTrue(t *testing.T, got bool, msgAndArgs ...any) bool {
 	t.Helper()
 	if !got {
-		t.Errorf("expected true, got false")
+		fail(t, msgAndArgs, "expected true, got false")
+		return false
 	}
+	return pass(t)
 }
 
 // AssertFalse asserts that the given value is false.
-func AssertFalse(t *testing.T, got bool) {
+func AssertFalse(t *testing.T, got bool, msgAndArgs ...any) bool {
 	t.Helper()
 	if got {
-		t.Errorf("expected false, got true")
+		fail(t, msgAndArgs, "expected false, got true")
+		return false
 	}
+	return pass(t)
 }
 
 // AssertEqual asserts that the given values are equal.
 // It uses reflection to do a deep comparison.
-func AssertEqual[T any](t *testing.T, a T, b T) {
+// When structs, maps, or slices differ, the failure lists each differing field or element.
+// Use AssertEqualWith to customize the comparison.
+func AssertEqual[T any](t *testing.T, a T, b T, msgAndArgs ...any) bool {
+	t.Helper()
+	if msg := equalMismatch(a, b, nil); msg != "" {
+		fail(t, msgAndArgs, "%s", msg)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertEqualWith is like AssertEqual, customizing the comparison with EqualOption values
+// (IgnoreFields, IgnoreUnexported, ApproxFloats).
+func AssertEqualWith[T any](t *testing.T, a T, b T, opts []EqualOption, msgAndArgs ...any) bool {
 	t.Helper()
-	if !isEqual(a, b) {
-		t.Errorf("expected values to equal, but %v does not equal %v", a, b)
+	if msg := equalMismatch(a, b, opts); msg != "" {
+		fail(t, msgAndArgs, "%s", msg)
+		return false
 	}
+	return pass(t)
 }
 
 // AssertNotEqual asserts that the given values are not equal.
 // It uses reflection to do a deep comparison.
-func AssertNotEqual[T any](t *testing.T, a T, b T) {
+func AssertNotEqual[T any](t *testing.T, a T, b T, msgAndArgs ...any) bool {
 	t.Helper()
 	if isEqual(a, b) {
-		t.Errorf("expected values not to equal, but got %v and %v", a, b)
+		fail(t, msgAndArgs, "expected values not to equal, but got %v and %v", a, b)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertNotEqualWith is like AssertNotEqual, customizing the comparison with EqualOption values.
+func AssertNotEqualWith[T any](t *testing.T, a T, b T, opts []EqualOption, msgAndArgs ...any) bool {
+	t.Helper()
+	if isEqualWith(a, b, opts) {
+		fail(t, msgAndArgs, "expected values not to equal, but got %v and %v", a, b)
+		return false
+	}
+	return pass(t)
+}
+
+// equalMismatch returns why a and b are not equal under the given options, or "" if they are.
+func equalMismatch[T any](a T, b T, opts []EqualOption) string {
+	if isEqualWith(a, b, opts) {
+		return ""
+	}
+	if lines := diff(a, b, opts...); isComposite(a) && len(lines) > 0 {
+		return fmt.Sprintf("expected values to equal, but found %d difference(s):\n\t%s", len(lines), joinLines(lines))
+	}
+	return fmt.Sprintf("expected values to equal, but %v does not equal %v", a, b)
+}
+
+// AssertMoneyEqual asserts that the given Money values are equal.
+// On failure, it prints both values in "amount currency" format and which of amount, currency, or precision differ.
+func AssertMoneyEqual(t *testing.T, got Money, want Money, msgAndArgs ...any) bool {
+	t.Helper()
+	if !got.Equals(want) {
+		fail(t, msgAndArgs, "expected %s, got %s (%s)", want.Format(), got.Format(), moneyDifferences(got, want))
+		return false
 	}
+	return pass(t)
 }
 
 // AssertErrorIs asserts that the given error is of the given type.
 // It uses the errors.Is to do the comparison, checking for wrapped errors.
-func AssertErrorIs(t *testing.T, got error, want error) {
+func AssertErrorIs(t *testing.T, got error, want error, msgAndArgs ...any) bool {
 	t.Helper()
 	if !errors.Is(got, want) {
-		t.Errorf("expected error '%v' to be '%v'", got, want)
+		fail(t, msgAndArgs, "expected error '%v' to be '%v'", got, want)
+		return false
 	}
+	return pass(t)
 }
 
 // AssertContains asserts that the given string contains the given substring.
-func AssertContains(t *testing.T, got string, substr string) {
+func AssertContains(t *testing.T, got string, substr string, msgAndArgs ...any) bool {
 	t.Helper()
 	if !strings.Contains(got, substr) {
-		t.Errorf("%q does not include the substring %q", got, substr)
+		fail(t, msgAndArgs, "%q does not include the substring %q", got, substr)
+		return false
 	}
+	return pass(t)
+}
+
+// AssertNotContains asserts that the given string does not contain the given substring.
+// Useful to make sure secrets or debug output do not leak into logs and rendered output.
+func AssertNotContains(t *testing.T, got string, substr string, msgAndArgs ...any) bool {
+	t.Helper()
+	if strings.Contains(got, substr) {
+		fail(t, msgAndArgs, "%q should not include the substring %q", got, substr)
+		return false
+	}
+	return pass(t)
 }
 
 // AssertPanics asserts that the given function panics.
-func AssertPanics(t *testing.T, f func()) {
+func AssertPanics(t *testing.T, f func(), msgAndArgs ...any) bool {
+	t.Helper()
+	if _, panicked := capturePanic(f); !panicked {
+		fail(t, msgAndArgs, "expected panic, but function did not panic")
+		return false
+	}
+	return pass(t)
+}
+
+// AssertPanicsWith asserts that the given function panics with a value equal to want.
+// It uses reflection to do a deep comparison.
+func AssertPanicsWith(t *testing.T, f func(), want any, msgAndArgs ...any) bool {
+	t.Helper()
+	got, panicked := capturePanic(f)
+	if !panicked {
+		fail(t, msgAndArgs, "expected panic with %v, but function did not panic", want)
+		return false
+	}
+	if !isEqual(got, want) {
+		fail(t, msgAndArgs, "expected panic with %v, got %v", want, got)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertPanicsMatch asserts that the given function panics with a value whose string form contains substr.
+// Useful for panics carrying errors, like the ones from SafeAdd.
+func AssertPanicsMatch(t *testing.T, f func(), substr string, msgAndArgs ...any) bool {
+	t.Helper()
+	got, panicked := capturePanic(f)
+	if !panicked {
+		fail(t, msgAndArgs, "expected panic matching %q, but function did not panic", substr)
+		return false
+	}
+	if !strings.Contains(fmt.Sprint(got), substr) {
+		fail(t, msgAndArgs, "expected panic matching %q, got %v", substr, got)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertNotPanics asserts that the given function does not panic.
+func AssertNotPanics(t *testing.T, f func(), msgAndArgs ...any) bool {
+	t.Helper()
+	if got, panicked := capturePanic(f); panicked {
+		fail(t, msgAndArgs, "expected no panic, but function panicked with %v", got)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertSliceContains asserts that the given slice contains elem.
+// It uses reflection to do a deep comparison.
+func AssertSliceContains[T any](t *testing.T, s []T, elem T, msgAndArgs ...any) bool {
+	t.Helper()
+	if !sliceContains(s, elem) {
+		fail(t, msgAndArgs, "%v does not contain %v", s, elem)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertSliceNotContains asserts that the given slice does not contain elem.
+// It uses reflection to do a deep comparison.
+func AssertSliceNotContains[T any](t *testing.T, s []T, elem T, msgAndArgs ...any) bool {
+	t.Helper()
+	if sliceContains(s, elem) {
+		fail(t, msgAndArgs, "%v should not contain %v", s, elem)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertElementsMatch asserts that both slices contain the same elements, ignoring order.
+// Duplicates must appear the same number of times in both. It uses reflection to do a deep comparison.
+func AssertElementsMatch[T any](t *testing.T, a []T, b []T, msgAndArgs ...any) bool {
+	t.Helper()
+	extra, missing := elementsDiff(a, b)
+	if len(extra) > 0 || len(missing) > 0 {
+		fail(t, msgAndArgs, "expected elements to match, but got extra %v and missing %v", extra, missing)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertSubset asserts that every element of subset is present in superset.
+// It uses reflection to do a deep comparison.
+func AssertSubset[T any](t *testing.T, subset []T, superset []T, msgAndArgs ...any) bool {
+	t.Helper()
+	var missing []T
+	for _, v := range subset {
+		if !sliceContains(superset, v) {
+			missing = append(missing, v)
+		}
+	}
+	if len(missing) > 0 {
+		fail(t, msgAndArgs, "expected %v to be a subset of %v, but %v are missing", subset, superset, missing)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertEmpty asserts that the given value is empty:
+// nil, a zero-length slice, map, string, array, or channel, or the zero value of any other type.
+func AssertEmpty(t *testing.T, got any, msgAndArgs ...any) bool {
+	t.Helper()
+	if !isEmpty(got) {
+		fail(t, msgAndArgs, "expected empty, got %v", got)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertNotEmpty asserts that the given value is not empty, as defined by AssertEmpty.
+func AssertNotEmpty(t *testing.T, got any, msgAndArgs ...any) bool {
+	t.Helper()
+	if isEmpty(got) {
+		fail(t, msgAndArgs, "expected non-empty, got %v", got)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertGreater asserts that got is greater than bound.
+func AssertGreater[T cmp.Ordered](t *testing.T, got T, bound T, msgAndArgs ...any) bool {
+	t.Helper()
+	if !(got > bound) {
+		fail(t, msgAndArgs, "expected %v to be greater than %v", got, bound)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertGreaterOrEqual asserts that got is greater than or equal to bound.
+func AssertGreaterOrEqual[T cmp.Ordered](t *testing.T, got T, bound T, msgAndArgs ...any) bool {
+	t.Helper()
+	if !(got >= bound) {
+		fail(t, msgAndArgs, "expected %v to be greater than or equal to %v", got, bound)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertLess asserts that got is less than bound.
+func AssertLess[T cmp.Ordered](t *testing.T, got T, bound T, msgAndArgs ...any) bool {
+	t.Helper()
+	if !(got < bound) {
+		fail(t, msgAndArgs, "expected %v to be less than %v", got, bound)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertLessOrEqual asserts that got is less than or equal to bound.
+func AssertLessOrEqual[T cmp.Ordered](t *testing.T, got T, bound T, msgAndArgs ...any) bool {
+	t.Helper()
+	if !(got <= bound) {
+		fail(t, msgAndArgs, "expected %v to be less than or equal to %v", got, bound)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertBetween asserts that got is within [low, high], both ends inclusive.
+func AssertBetween[T cmp.Ordered](t *testing.T, got T, low T, high T, msgAndArgs ...any) bool {
 	t.Helper()
+	if !(got >= low && got <= high) {
+		fail(t, msgAndArgs, "expected %v to be between %v and %v", got, low, high)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertSorted asserts that the given slice is sorted in ascending order.
+func AssertSorted[T cmp.Ordered](t *testing.T, s []T, msgAndArgs ...any) bool {
+	t.Helper()
+	return AssertSortedBy(t, s, cmp.Compare[T], msgAndArgs...)
+}
+
+// AssertSortedBy asserts that the given slice is sorted according to compare,
+// which returns a negative number when a < b, a positive number when a > b and zero otherwise,
+// as in slices.SortFunc.
+func AssertSortedBy[T any](t *testing.T, s []T, compare func(a, b T) int, msgAndArgs ...any) bool {
+	t.Helper()
+	if i := firstUnsorted(s, compare); i >= 0 {
+		fail(t, msgAndArgs, "expected slice to be sorted, but %v at index %d comes after %v at index %d", s[i+1], i+1, s[i], i)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertEventually asserts that cond returns true within timeout, checking it every interval.
+// Useful for asynchronous code such as goroutines, caches, and watchers.
+func AssertEventually(t *testing.T, cond func() bool, timeout, interval time.Duration, msgAndArgs ...any) bool {
+	t.Helper()
+	return AssertEventuallyWithClock(t, RealClock{}, cond, timeout, interval, msgAndArgs...)
+}
+
+// AssertEventuallyWithClock is like AssertEventually but measures time with the given Clock.
+// With a FakeClock, it drives the clock itself: it advances it by interval between checks, up to timeout,
+// so code waiting on the same clock makes progress without real delays. The clock is left advanced afterwards,
+// so don't Advance it concurrently from the test.
+func AssertEventuallyWithClock(t *testing.T, clock Clock, cond func() bool, timeout, interval time.Duration, msgAndArgs ...any) bool {
+	t.Helper()
+	met, err := poll(clock, cond, timeout, interval)
+	if err != nil {
+		fail(t, msgAndArgs, "%v", err)
+		return false
+	}
+	if !met {
+		fail(t, msgAndArgs, "condition not met within %v", timeout)
+		return false
+	}
+	return pass(t)
+}
 
+// AssertNever asserts that cond does not return true within timeout, checking it every interval.
+func AssertNever(t *testing.T, cond func() bool, timeout, interval time.Duration, msgAndArgs ...any) bool {
+	t.Helper()
+	return AssertNeverWithClock(t, RealClock{}, cond, timeout, interval, msgAndArgs...)
+}
+
+// AssertNeverWithClock is like AssertNever but measures time with the given Clock.
+// Like AssertEventuallyWithClock, it drives a FakeClock, leaving it advanced by up to timeout.
+func AssertNeverWithClock(t *testing.T, clock Clock, cond func() bool, timeout, interval time.Duration, msgAndArgs ...any) bool {
+	t.Helper()
+	met, err := poll(clock, cond, timeout, interval)
+	if err != nil {
+		fail(t, msgAndArgs, "%v", err)
+		return false
+	}
+	if met {
+		fail(t, msgAndArgs, "condition met, but expected it never to be within %v", timeout)
+		return false
+	}
+	return pass(t)
+}
+
+// fakeClockYields bounds how many times poll yields to other goroutines after each FakeClock step,
+// checking cond in between, before advancing the clock again.
+const fakeClockYields = 100
+
+// poll checks cond immediately and then every interval, reporting whether it returned true before timeout.
+// It returns an error for a non-positive interval, which would otherwise never finish with a FakeClock.
+func poll(clock Clock, cond func() bool, timeout, interval time.Duration) (bool, error) {
+	if interval <= 0 {
+		return false, fmt.Errorf("interval must be positive, got %v", interval)
+	}
+	if cond() {
+		return true, nil
+	}
+
+	if fake, ok := clock.(*FakeClock); ok {
+		for elapsed := interval; elapsed <= timeout; elapsed += interval {
+			fake.Advance(interval)
+			// Give goroutines woken up by the clock a chance to run, without depending on real time.
+			for range fakeClockYields {
+				if cond() {
+					return true, nil
+				}
+				runtime.Gosched()
+			}
+		}
+		return false, nil
+	}
+
+	deadline := clock.After(timeout)
+	ticker := clock.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-deadline:
+			return false, nil
+		case <-ticker.C():
+			if cond() {
+				return true, nil
+			}
+		}
+	}
+}
+
+// capturePanic runs f and returns the recovered value, if any.
+// panicked is reported separately because a function may panic with nil.
+func capturePanic(f func()) (recovered any, panicked bool) {
+	panicked = true
 	defer func() {
-		if r := recover(); r == nil {
-			t.Errorf("expected panic, but function did not panic")
-			return
+		if panicked {
+			recovered = recover()
 		}
 	}()
 
 	f()
+	panicked = false
+	return
+}
+
+// fail reports an assertion failure, appending the optional user message.
+func fail(t *testing.T, msgAndArgs []any, format string, args ...any) {
+	t.Helper()
+	track(t, false)
+	msg := fmt.Sprintf(format, args...)
+	if extra := formatMsgAndArgs(msgAndArgs...); extra != "" {
+		msg += ": " + extra
+	}
+	t.Error(msg)
+}
+
+func moneyDifferences(got Money, want Money) string {
+	var diffs []string
+	if got.Amount() != want.Amount() {
+		diffs = append(diffs, fmt.Sprintf("amount differs: %d != %d", got.Amount(), want.Amount()))
+	}
+	if got.Currency() != want.Currency() {
+		diffs = append(diffs, fmt.Sprintf("currency differs: %s != %s", got.Currency(), want.Currency()))
+	}
+	if got.Precision() != want.Precision() {
+		diffs = append(diffs, fmt.Sprintf("precision differs: %d != %d", got.Precision(), want.Precision()))
+	}
+	return strings.Join(diffs, ", ")
+}
+
+// joinLines joins diff lines so each one is indented under the failure message.
+func joinLines(lines []string) string {
+	return strings.Join(lines, "\n\t")
+}
+
+func formatMsgAndArgs(msgAndArgs ...any) string {
+	if len(msgAndArgs) == 0 {
+		return ""
+	}
+	if format, ok := msgAndArgs[0].(string); ok && len(msgAndArgs) > 1 {
+		return fmt.Sprintf(format, msgAndArgs[1:]...)
+	}
+	return fmt.Sprint(msgAndArgs...)
+}
+
+// isEqualWith compares like isEqual, honoring the given options.
+func isEqualWith[T any](got T, want T, opts []EqualOption) bool {
+	if len(opts) == 0 {
+		return isEqual(got, want)
+	}
+	if isNil(got) && isNil(want) {
+		return true
+	}
+	return len(diff(got, want, opts...)) == 0
 }
 
 func isEqual[T any](got T, want T) bool {
@@ -95,6 +515,69 @@ func isEqual[T any](got T, want T) bool {
 	return reflect.DeepEqual(got, want)
 }
 
+func sliceContains[T any](s []T, elem T) bool {
+	for _, v := range s {
+		if isEqual(v, elem) {
+			return true
+		}
+	}
+	return false
+}
+
+// firstUnsorted returns the index i of the first pair where s[i+1] sorts before s[i], or -1 if s is sorted.
+func firstUnsorted[T any](s []T, compare func(a, b T) int) int {
+	for i := 0; i+1 < len(s); i++ {
+		if compare(s[i+1], s[i]) < 0 {
+			return i
+		}
+	}
+	return -1
+}
+
+// elementsDiff matches elements of a and b one to one,
+// returning those in a without a match in b (extra) and those in b without a match in a (missing).
+func elementsDiff[T any](a []T, b []T) (extra []T, missing []T) {
+	matched := make([]bool, len(b))
+	for _, av := range a {
+		found := false
+		for i, bv := range b {
+			if !matched[i] && isEqual(av, bv) {
+				matched[i] = true
+				found = true
+				break
+			}
+		}
+		if !found {
+			extra = append(extra, av)
+		}
+	}
+	for i, bv := range b {
+		if !matched[i] {
+			missing = append(missing, bv)
+		}
+	}
+	return extra, missing
+}
+
+func isEmpty(v any) bool {
+	if v == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map, reflect.String, reflect.Array, reflect.Chan:
+		return rv.Len() == 0
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return true
+		}
+		return isEmpty(rv.Elem().Interface())
+	}
+
+	return rv.IsZero()
+}
+
 func isNil(v any) bool {
 	if v == nil {
 		return true
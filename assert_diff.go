@@ -0,0 +1,235 @@
+package pocket
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// EqualOption customizes how AssertEqualWith and AssertNotEqualWith compare values.
+//
+// Example:
+//
+//	AssertEqualWith(t, got, want, []EqualOption{IgnoreFields("ID", "CreatedAt"), ApproxFloats(1e-9)}, "order %d", id)
+type EqualOption func(*equalConfig)
+
+type equalConfig struct {
+	ignoredFields       map[string]bool
+	ignoreAllUnexported bool
+	ignoredUnexported   map[reflect.Type]bool
+	floatTolerance      float64
+}
+
+// IgnoreFields ignores struct fields with the given names, at any depth.
+func IgnoreFields(names ...string) EqualOption {
+	return func(c *equalConfig) {
+		for _, name := range names {
+			c.ignoredFields[name] = true
+		}
+	}
+}
+
+// IgnoreUnexported ignores unexported fields of the structs with the same type as the given values.
+// Called without arguments, it ignores unexported fields of all structs.
+//
+// Example:
+//
+//	IgnoreUnexported(Order{}, Customer{})
+func IgnoreUnexported(types ...any) EqualOption {
+	return func(c *equalConfig) {
+		if len(types) == 0 {
+			c.ignoreAllUnexported = true
+		}
+		for _, v := range types {
+			c.ignoredUnexported[reflect.TypeOf(v)] = true
+		}
+	}
+}
+
+// ApproxFloats considers floats equal when they differ by no more than epsilon.
+func ApproxFloats(epsilon float64) EqualOption {
+	return func(c *equalConfig) {
+		c.floatTolerance = epsilon
+	}
+}
+
+// diff compares two values field by field, element by element, and returns one line per difference.
+// Each line starts with the path to the differing value, e.g. `.Items[2].Price: 100 != 200`.
+func diff(a, b any, opts ...EqualOption) []string {
+	d := differ{
+		visited: map[[2]uintptr]bool{},
+		config: equalConfig{
+			ignoredFields:     map[string]bool{},
+			ignoredUnexported: map[reflect.Type]bool{},
+		},
+	}
+	for _, opt := range opts {
+		opt(&d.config)
+	}
+	d.compare(reflect.ValueOf(a), reflect.ValueOf(b), "")
+	return d.lines
+}
+
+// isComposite reports whether v is a struct, map, slice, or array (or a pointer to one),
+// that is, a value for which a structured diff is more useful than printing it whole.
+func isComposite(v any) bool {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return false
+		}
+		rv = rv.Elem()
+	}
+	switch rv.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array:
+		return true
+	}
+	return false
+}
+
+type differ struct {
+	lines   []string
+	visited map[[2]uintptr]bool
+	config  equalConfig
+}
+
+func (d *differ) ignoreField(structType reflect.Type, field reflect.StructField) bool {
+	if d.config.ignoredFields[field.Name] {
+		return true
+	}
+	if !field.IsExported() {
+		return d.config.ignoreAllUnexported || d.config.ignoredUnexported[structType]
+	}
+	return false
+}
+
+func (d *differ) report(path string, a, b string) {
+	if path == "" {
+		path = "."
+	}
+	d.lines = append(d.lines, fmt.Sprintf("%s: %s != %s", path, a, b))
+}
+
+func (d *differ) compare(a, b reflect.Value, path string) {
+	if !a.IsValid() || !b.IsValid() {
+		if a.IsValid() != b.IsValid() {
+			d.report(path, formatValue(a), formatValue(b))
+		}
+		return
+	}
+
+	if a.Type() != b.Type() {
+		d.report(path, fmt.Sprintf("%s(%s)", a.Type(), formatValue(a)), fmt.Sprintf("%s(%s)", b.Type(), formatValue(b)))
+		return
+	}
+
+	switch a.Kind() {
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			field := a.Type().Field(i)
+			if d.ignoreField(a.Type(), field) {
+				continue
+			}
+			d.compare(a.Field(i), b.Field(i), path+"."+field.Name)
+		}
+
+	case reflect.Slice, reflect.Array:
+		if a.Kind() == reflect.Slice && a.IsNil() != b.IsNil() {
+			d.report(path, formatValue(a), formatValue(b))
+			return
+		}
+		for i := 0; i < max(a.Len(), b.Len()); i++ {
+			elemPath := fmt.Sprintf("%s[%d]", path, i)
+			switch {
+			case i >= a.Len():
+				d.report(elemPath, "<missing>", formatValue(b.Index(i)))
+			case i >= b.Len():
+				d.report(elemPath, formatValue(a.Index(i)), "<missing>")
+			default:
+				d.compare(a.Index(i), b.Index(i), elemPath)
+			}
+		}
+
+	case reflect.Map:
+		if a.IsNil() != b.IsNil() {
+			d.report(path, formatValue(a), formatValue(b))
+			return
+		}
+		for _, k := range mapKeysUnion(a, b) {
+			keyPath := fmt.Sprintf("%s[%s]", path, formatValue(k))
+			av, bv := a.MapIndex(k), b.MapIndex(k)
+			switch {
+			case !av.IsValid():
+				d.report(keyPath, "<missing>", formatValue(bv))
+			case !bv.IsValid():
+				d.report(keyPath, formatValue(av), "<missing>")
+			default:
+				d.compare(av, bv, keyPath)
+			}
+		}
+
+	case reflect.Pointer, reflect.Interface:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				d.report(path, formatValue(a), formatValue(b))
+			}
+			return
+		}
+		if a.Kind() == reflect.Pointer {
+			// Guard against cycles.
+			key := [2]uintptr{a.Pointer(), b.Pointer()}
+			if key[0] == key[1] || d.visited[key] {
+				return
+			}
+			d.visited[key] = true
+		}
+		d.compare(a.Elem(), b.Elem(), path)
+
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		if a.Pointer() != b.Pointer() {
+			d.report(path, formatValue(a), formatValue(b))
+		}
+
+	case reflect.Float32, reflect.Float64:
+		if a.Float() != b.Float() && !(math.Abs(a.Float()-b.Float()) <= d.config.floatTolerance) {
+			d.report(path, formatValue(a), formatValue(b))
+		}
+
+	default:
+		if !a.Equal(b) {
+			d.report(path, formatValue(a), formatValue(b))
+		}
+	}
+}
+
+// mapKeysUnion returns the keys of both maps, sorted by their printed form for a stable output.
+func mapKeysUnion(a, b reflect.Value) []reflect.Value {
+	seen := map[string]bool{}
+	var keys []reflect.Value
+	for _, m := range []reflect.Value{a, b} {
+		for _, k := range m.MapKeys() {
+			s := formatValue(k)
+			if !seen[s] {
+				seen[s] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	slices.SortFunc(keys, func(x, y reflect.Value) int {
+		return strings.Compare(formatValue(x), formatValue(y))
+	})
+	return keys
+}
+
+func formatValue(v reflect.Value) string {
+	if !v.IsValid() {
+		return "<nil>"
+	}
+	if v.Kind() == reflect.String {
+		return strconv.Quote(v.String())
+	}
+	return fmt.Sprintf("%v", v)
+}
@@ -0,0 +1,134 @@
+package pocket
+
+import "testing"
+
+func TestDiff(t *testing.T) {
+	type item struct {
+		Name  string
+		Price Money
+	}
+	type order struct {
+		ID    int
+		Items []item
+		Tags  map[string]string
+		Note  *string
+	}
+
+	note := "fragile"
+
+	tests := []struct {
+		name string
+		a    any
+		b    any
+		want []string
+	}{
+		{
+			name: "equal structs",
+			a:    order{ID: 1, Items: []item{{Name: "pen"}}},
+			b:    order{ID: 1, Items: []item{{Name: "pen"}}},
+			want: nil,
+		},
+		{
+			name: "struct fields",
+			a:    order{ID: 1},
+			b:    order{ID: 2},
+			want: []string{".ID: 1 != 2"},
+		},
+		{
+			name: "nested unexported fields",
+			a:    order{Items: []item{{Name: "pen", Price: NewUSD(100)}}},
+			b:    order{Items: []item{{Name: "pen", Price: NewUSD(200)}}},
+			want: []string{".Items[0].Price.amount: 100 != 200"},
+		},
+		{
+			name: "slice length",
+			a:    []int{1, 2},
+			b:    []int{1, 2, 3},
+			want: []string{"[2]: <missing> != 3"},
+		},
+		{
+			name: "map keys and values",
+			a:    map[string]int{"a": 1, "b": 2},
+			b:    map[string]int{"a": 1, "b": 3, "c": 4},
+			want: []string{`["b"]: 2 != 3`, `["c"]: <missing> != 4`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, diff(tt.a, tt.b), tt.want)
+		})
+	}
+
+	t.Run("nil pointer", func(t *testing.T) {
+		t.Parallel()
+		lines := diff(order{Note: &note}, order{})
+		AssertEqual(t, len(lines), 1)
+		AssertContains(t, lines[0], ".Note: ")
+		AssertContains(t, lines[0], "!= <nil>")
+	})
+}
+
+func TestIsComposite(t *testing.T) {
+	AssertTrue(t, isComposite(struct{}{}))
+	AssertTrue(t, isComposite([]int{}))
+	AssertTrue(t, isComposite(map[string]int{}))
+	AssertTrue(t, isComposite(&struct{}{}))
+	AssertFalse(t, isComposite(42))
+	AssertFalse(t, isComposite("pocket"))
+	AssertFalse(t, isComposite((*struct{})(nil)))
+}
+
+func TestDiffWithOptions(t *testing.T) {
+	type audit struct {
+		ID        int
+		CreatedAt string
+		Amount    float64
+		note      string
+	}
+
+	x, y := 0.1, 0.2
+	a := audit{ID: 1, CreatedAt: "monday", Amount: x + y, note: "a"}
+	b := audit{ID: 2, CreatedAt: "tuesday", Amount: 0.3, note: "b"}
+
+	t.Run("no options", func(t *testing.T) {
+		AssertEqual(t, len(diff(a, b)), 4)
+	})
+
+	t.Run("ignore fields", func(t *testing.T) {
+		AssertEqual(t, diff(a, b, IgnoreFields("ID", "CreatedAt")), []string{
+			".Amount: 0.30000000000000004 != 0.3",
+			`.note: "a" != "b"`,
+		})
+	})
+
+	t.Run("ignore unexported of a type", func(t *testing.T) {
+		lines := diff(a, b, IgnoreFields("ID", "CreatedAt"), IgnoreUnexported(audit{}))
+		AssertEqual(t, lines, []string{".Amount: 0.30000000000000004 != 0.3"})
+	})
+
+	t.Run("ignore unexported of other types", func(t *testing.T) {
+		lines := diff(a, b, IgnoreFields("ID", "CreatedAt", "Amount"), IgnoreUnexported(Money{}))
+		AssertEqual(t, lines, []string{`.note: "a" != "b"`})
+	})
+
+	t.Run("approximate floats", func(t *testing.T) {
+		lines := diff(a, b, IgnoreFields("ID", "CreatedAt"), IgnoreUnexported(), ApproxFloats(1e-9))
+		AssertEqual(t, len(lines), 0)
+	})
+
+	t.Run("assert equal with options", func(t *testing.T) {
+		opts := []EqualOption{IgnoreFields("ID", "CreatedAt", "note"), ApproxFloats(1e-9)}
+		AssertEqualWith(t, a, b, opts)
+		AssertEqualWith(t, a, b, opts, "comparing %s", "audits")
+		AssertNotEqualWith(t, a, b, []EqualOption{IgnoreFields("ID")}, "audit %d", 1)
+		RequireEqualWith(t, a, b, opts, "comparing audits")
+		RequireNotEqualWith(t, a, b, []EqualOption{IgnoreFields("ID")})
+	})
+
+	t.Run("options are not message arguments", func(t *testing.T) {
+		// An EqualOption passed to AssertEqual is only ever part of the message, never the comparison.
+		AssertNotEqual(t, a, b, "ignoring %v", IgnoreFields("ID", "CreatedAt", "note"))
+	})
+}
@@ -0,0 +1,120 @@
+package pocket
+
+import (
+	"reflect"
+	"testing"
+)
+
+// Checker is the entry point of the fluent assertion API, layered on top of the Assert* functions.
+//
+// Example:
+//
+//	check := pocket.Check(t)
+//	check.That(got).Equals(want)
+//	check.That(err).IsNil()
+//	check.That(names).Contains("Alice")
+type Checker struct {
+	t *testing.T
+}
+
+// Subject is a value under test, created with Checker.That.
+type Subject struct {
+	t   *testing.T
+	got any
+}
+
+// Check returns a Checker that reports failures to t.
+func Check(t *testing.T) Checker {
+	return Checker{t: t}
+}
+
+// That returns a Subject to run assertions on the given value.
+func (c Checker) That(got any) Subject {
+	return Subject{t: c.t, got: got}
+}
+
+// Equals asserts that the subject is deeply equal to want, as in AssertEqual.
+func (s Subject) Equals(want any, msgAndArgs ...any) bool {
+	s.t.Helper()
+	return AssertEqual(s.t, s.got, want, msgAndArgs...)
+}
+
+// NotEquals asserts that the subject is not deeply equal to want, as in AssertNotEqual.
+func (s Subject) NotEquals(want any, msgAndArgs ...any) bool {
+	s.t.Helper()
+	return AssertNotEqual(s.t, s.got, want, msgAndArgs...)
+}
+
+// IsNil asserts that the subject is nil.
+func (s Subject) IsNil(msgAndArgs ...any) bool {
+	s.t.Helper()
+	return AssertNil(s.t, s.got, msgAndArgs...)
+}
+
+// IsNotNil asserts that the subject is not nil.
+func (s Subject) IsNotNil(msgAndArgs ...any) bool {
+	s.t.Helper()
+	return AssertNotNil(s.t, s.got, msgAndArgs...)
+}
+
+// IsTrue asserts that the subject is the boolean true.
+func (s Subject) IsTrue(msgAndArgs ...any) bool {
+	s.t.Helper()
+	return AssertEqual(s.t, s.got, any(true), msgAndArgs...)
+}
+
+// IsFalse asserts that the subject is the boolean false.
+func (s Subject) IsFalse(msgAndArgs ...any) bool {
+	s.t.Helper()
+	return AssertEqual(s.t, s.got, any(false), msgAndArgs...)
+}
+
+// IsEmpty asserts that the subject is empty, as in AssertEmpty.
+func (s Subject) IsEmpty(msgAndArgs ...any) bool {
+	s.t.Helper()
+	return AssertEmpty(s.t, s.got, msgAndArgs...)
+}
+
+// IsNotEmpty asserts that the subject is not empty, as in AssertNotEmpty.
+func (s Subject) IsNotEmpty(msgAndArgs ...any) bool {
+	s.t.Helper()
+	return AssertNotEmpty(s.t, s.got, msgAndArgs...)
+}
+
+// ErrorIs asserts that the subject is an error matching want, as in AssertErrorIs.
+func (s Subject) ErrorIs(want error, msgAndArgs ...any) bool {
+	s.t.Helper()
+	err, ok := s.got.(error)
+	if !ok && s.got != nil {
+		fail(s.t, msgAndArgs, "expected an error, got %T", s.got)
+		return false
+	}
+	return AssertErrorIs(s.t, err, want, msgAndArgs...)
+}
+
+// Contains asserts that the subject contains x.
+// Strings are checked for a substring, slices and arrays for an element (using a deep comparison).
+func (s Subject) Contains(x any, msgAndArgs ...any) bool {
+	s.t.Helper()
+
+	if str, ok := s.got.(string); ok {
+		substr, ok := x.(string)
+		if !ok {
+			fail(s.t, msgAndArgs, "cannot check if string %q contains %T", str, x)
+			return false
+		}
+		return AssertContains(s.t, str, substr, msgAndArgs...)
+	}
+
+	rv := reflect.ValueOf(s.got)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		fail(s.t, msgAndArgs, "cannot check if %T contains %v", s.got, x)
+		return false
+	}
+
+	elems := make([]any, rv.Len())
+	for i := range elems {
+		elems[i] = rv.Index(i).Interface()
+	}
+	return AssertSliceContains(s.t, elems, x, msgAndArgs...)
+}
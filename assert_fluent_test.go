@@ -0,0 +1,44 @@
+package pocket
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	t.Run("equality", func(t *testing.T) {
+		check := Check(t)
+		AssertTrue(t, check.That(NewUSD(100)).Equals(NewUSD(100)))
+		AssertTrue(t, check.That(1).NotEquals(2))
+		AssertTrue(t, check.That([]int{1, 2}).Equals([]int{1, 2}))
+	})
+
+	t.Run("nil and booleans", func(t *testing.T) {
+		check := Check(t)
+		var err error
+		AssertTrue(t, check.That(err).IsNil())
+		AssertTrue(t, check.That(&struct{}{}).IsNotNil())
+		AssertTrue(t, check.That(1 < 2).IsTrue())
+		AssertTrue(t, check.That(1 > 2).IsFalse())
+	})
+
+	t.Run("emptiness", func(t *testing.T) {
+		check := Check(t)
+		AssertTrue(t, check.That("").IsEmpty())
+		AssertTrue(t, check.That(map[string]int{"a": 1}).IsNotEmpty())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		check := Check(t)
+		sentinel := errors.New("not found")
+		AssertTrue(t, check.That(fmt.Errorf("loading user: %w", sentinel)).ErrorIs(sentinel))
+	})
+
+	t.Run("contains", func(t *testing.T) {
+		check := Check(t)
+		AssertTrue(t, check.That("hello pocket").Contains("pocket"))
+		AssertTrue(t, check.That([]string{"a", "b"}).Contains("b"))
+		AssertTrue(t, check.That([2]int{1, 2}).Contains(2))
+	})
+}
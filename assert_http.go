@@ -0,0 +1,79 @@
+package pocket
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+)
+
+// AssertHTTPStatus asserts that the recorded response has the given status code.
+func AssertHTTPStatus(t *testing.T, rec *httptest.ResponseRecorder, want int, msgAndArgs ...any) bool {
+	t.Helper()
+	if rec.Code != want {
+		fail(t, msgAndArgs, "expected status %d, got %d (body: %q)", want, rec.Code, rec.Body.String())
+		return false
+	}
+	return pass(t)
+}
+
+// AssertHTTPHeader asserts that the recorded response has the given header value.
+func AssertHTTPHeader(t *testing.T, rec *httptest.ResponseRecorder, key string, want string, msgAndArgs ...any) bool {
+	t.Helper()
+	if got := rec.Header().Get(key); got != want {
+		fail(t, msgAndArgs, "expected header %s to be %q, got %q", key, want, got)
+		return false
+	}
+	return pass(t)
+}
+
+// AssertHTTPBodyJSON asserts that the recorded response body is JSON equivalent to want.
+// want can be a JSON string, a []byte, or any value that marshals to JSON (a struct, a map, etc.).
+// Formatting and key order are ignored.
+func AssertHTTPBodyJSON(t *testing.T, rec *httptest.ResponseRecorder, want any, msgAndArgs ...any) bool {
+	t.Helper()
+
+	var got any
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		fail(t, msgAndArgs, "expected body to be JSON, got %q: %v", rec.Body.String(), err)
+		return false
+	}
+
+	expected, err := normalizeJSON(want)
+	if err != nil {
+		fail(t, msgAndArgs, "cannot use %v as expected JSON: %v", want, err)
+		return false
+	}
+
+	if !isEqual(got, expected) {
+		if lines := diff(got, expected); len(lines) > 0 {
+			fail(t, msgAndArgs, "expected JSON bodies to equal, but found %d difference(s):\n\t%s", len(lines), joinLines(lines))
+			return false
+		}
+		fail(t, msgAndArgs, "expected JSON body %v, got %v", expected, got)
+		return false
+	}
+	return pass(t)
+}
+
+// normalizeJSON turns v into the generic form produced by json.Unmarshal into an `any`.
+func normalizeJSON(v any) (any, error) {
+	var raw []byte
+	switch v := v.(type) {
+	case string:
+		raw = []byte(v)
+	case []byte:
+		raw = v
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		raw = b
+	}
+
+	var normalized any
+	if err := json.Unmarshal(raw, &normalized); err != nil {
+		return nil, err
+	}
+	return normalized, nil
+}
@@ -0,0 +1,52 @@
+package pocket
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPAssertions(t *testing.T) {
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]any{"id": 7, "name": "pocket", "tags": []string{"go"}})
+	})
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/items", nil))
+
+	t.Run("status", func(t *testing.T) {
+		AssertTrue(t, AssertHTTPStatus(t, rec, http.StatusCreated))
+	})
+
+	t.Run("header", func(t *testing.T) {
+		AssertTrue(t, AssertHTTPHeader(t, rec, "Content-Type", "application/json"))
+	})
+
+	t.Run("body from string", func(t *testing.T) {
+		AssertTrue(t, AssertHTTPBodyJSON(t, rec, `{"name": "pocket", "tags": ["go"], "id": 7}`))
+	})
+
+	t.Run("body from struct", func(t *testing.T) {
+		type item struct {
+			ID   int      `json:"id"`
+			Name string   `json:"name"`
+			Tags []string `json:"tags"`
+		}
+		AssertTrue(t, AssertHTTPBodyJSON(t, rec, item{ID: 7, Name: "pocket", Tags: []string{"go"}}))
+	})
+}
+
+func TestNormalizeJSON(t *testing.T) {
+	fromString, err := normalizeJSON(`{"a": 1, "b": [true]}`)
+	AssertNil(t, err)
+
+	fromMap, err := normalizeJSON(map[string]any{"b": []bool{true}, "a": 1})
+	AssertNil(t, err)
+	AssertEqual(t, fromString, fromMap)
+
+	_, err = normalizeJSON("{not json")
+	AssertNotNil(t, err)
+}
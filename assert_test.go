@@ -0,0 +1,300 @@
+package pocket
+
+import (
+	"cmp"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestFormatMsgAndArgs(t *testing.T) {
+	tests := []struct {
+		name       string
+		msgAndArgs []any
+		want       string
+	}{
+		{
+			name:       "no message",
+			msgAndArgs: nil,
+			want:       "",
+		},
+		{
+			name:       "plain message",
+			msgAndArgs: []any{"fixture users.json"},
+			want:       "fixture users.json",
+		},
+		{
+			name:       "format with args",
+			msgAndArgs: []any{"case %d: %s", 3, "negative amount"},
+			want:       "case 3: negative amount",
+		},
+		{
+			name:       "non-string value",
+			msgAndArgs: []any{42},
+			want:       "42",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, formatMsgAndArgs(tt.msgAndArgs...), tt.want)
+		})
+	}
+}
+
+func TestAssertionsReturnResult(t *testing.T) {
+	AssertTrue(t, AssertNil(t, nil))
+	AssertTrue(t, AssertEqual(t, 1, 1))
+	AssertTrue(t, AssertContains(t, "pocket", "ock"))
+	AssertTrue(t, AssertNotContains(t, "password=***", "hunter2"))
+	AssertTrue(t, AssertPanics(t, func() { panic("boom") }))
+}
+
+func TestCapturePanic(t *testing.T) {
+	t.Run("no panic", func(t *testing.T) {
+		got, panicked := capturePanic(func() {})
+		AssertFalse(t, panicked)
+		AssertNil(t, got)
+	})
+
+	t.Run("panic with value", func(t *testing.T) {
+		got, panicked := capturePanic(func() { panic("boom") })
+		AssertTrue(t, panicked)
+		AssertEqual(t, got, any("boom"))
+	})
+
+	t.Run("panic with error", func(t *testing.T) {
+		got, panicked := capturePanic(func() { SafeAdd(int8(127), int8(1)) })
+		AssertTrue(t, panicked)
+		AssertContains(t, got.(error).Error(), "integer overflow")
+	})
+}
+
+func TestPanicAssertions(t *testing.T) {
+	AssertTrue(t, AssertPanicsWith(t, func() { panic("boom") }, "boom"))
+	AssertTrue(t, AssertPanicsMatch(t, func() { panic("kaboom") }, "boom"))
+	AssertTrue(t, AssertNotPanics(t, func() {}))
+}
+
+func TestPoll(t *testing.T) {
+	t.Run("true immediately", func(t *testing.T) {
+		t.Parallel()
+		met, err := poll(RealClock{}, func() bool { return true }, time.Millisecond, time.Millisecond)
+		AssertNil(t, err)
+		AssertTrue(t, met)
+	})
+
+	t.Run("becomes true", func(t *testing.T) {
+		t.Parallel()
+		var done atomic.Bool
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			done.Store(true)
+		}()
+		met, err := poll(RealClock{}, done.Load, time.Second, time.Millisecond)
+		AssertNil(t, err)
+		AssertTrue(t, met)
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		t.Parallel()
+		met, err := poll(RealClock{}, func() bool { return false }, 20*time.Millisecond, time.Millisecond)
+		AssertNil(t, err)
+		AssertFalse(t, met)
+	})
+
+	t.Run("non-positive interval", func(t *testing.T) {
+		t.Parallel()
+		for _, clock := range []Clock{RealClock{}, NewFakeClock(epoch)} {
+			for _, interval := range []time.Duration{0, -time.Millisecond} {
+				met, err := poll(clock, func() bool { return false }, time.Second, interval)
+				AssertNotNil(t, err)
+				AssertContains(t, err.Error(), "interval must be positive")
+				AssertFalse(t, met)
+			}
+		}
+	})
+}
+
+func TestEventuallyAndNever(t *testing.T) {
+	var calls atomic.Int32
+	AssertTrue(t, AssertEventually(t, func() bool { return calls.Add(1) >= 3 }, time.Second, time.Millisecond))
+	AssertTrue(t, AssertNever(t, func() bool { return false }, 20*time.Millisecond, time.Millisecond))
+}
+
+func TestOrderingAssertions(t *testing.T) {
+	AssertTrue(t, AssertGreater(t, 2, 1))
+	AssertTrue(t, AssertGreaterOrEqual(t, 2, 2))
+	AssertTrue(t, AssertLess(t, "a", "b"))
+	AssertTrue(t, AssertLessOrEqual(t, 1.5, 1.5))
+	AssertTrue(t, AssertBetween(t, 5, 1, 10))
+	AssertTrue(t, AssertBetween(t, 10, 1, 10))
+}
+
+func TestIsEmpty(t *testing.T) {
+	var nilSlice []int
+	var nilMap map[string]int
+	var nilPtr *int
+	zero := 0
+	one := 1
+
+	tests := []struct {
+		name string
+		v    any
+		want bool
+	}{
+		{name: "nil", v: nil, want: true},
+		{name: "nil slice", v: nilSlice, want: true},
+		{name: "empty slice", v: []int{}, want: true},
+		{name: "slice", v: []int{1}, want: false},
+		{name: "nil map", v: nilMap, want: true},
+		{name: "map", v: map[string]int{"a": 1}, want: false},
+		{name: "empty string", v: "", want: true},
+		{name: "string", v: "pocket", want: false},
+		{name: "empty channel", v: make(chan int, 1), want: true},
+		{name: "zero struct", v: struct{ A int }{}, want: true},
+		{name: "struct", v: struct{ A int }{A: 1}, want: false},
+		{name: "zero int", v: 0, want: true},
+		{name: "nil pointer", v: nilPtr, want: true},
+		{name: "pointer to zero", v: &zero, want: true},
+		{name: "pointer to value", v: &one, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, isEmpty(tt.v), tt.want)
+		})
+	}
+}
+
+func TestSliceContains(t *testing.T) {
+	type point struct{ X, Y int }
+
+	AssertTrue(t, sliceContains([]int{1, 2, 3}, 2))
+	AssertFalse(t, sliceContains([]int{1, 2, 3}, 4))
+	AssertFalse(t, sliceContains(nil, "a"))
+	AssertTrue(t, sliceContains([]point{{1, 2}, {3, 4}}, point{3, 4}))
+	AssertTrue(t, sliceContains([][]int{{1}, {2, 3}}, []int{2, 3}))
+	AssertTrue(t, AssertSliceNotContains(t, []string{"a", "b"}, "c"))
+}
+
+func TestElementsDiff(t *testing.T) {
+	tests := []struct {
+		name        string
+		a           []int
+		b           []int
+		wantExtra   []int
+		wantMissing []int
+	}{
+		{
+			name: "same order",
+			a:    []int{1, 2, 3},
+			b:    []int{1, 2, 3},
+		},
+		{
+			name: "different order",
+			a:    []int{3, 1, 2},
+			b:    []int{1, 2, 3},
+		},
+		{
+			name:        "multiplicity matters",
+			a:           []int{1, 1, 2},
+			b:           []int{1, 2, 2},
+			wantExtra:   []int{1},
+			wantMissing: []int{2},
+		},
+		{
+			name:        "extra and missing",
+			a:           []int{1, 4},
+			b:           []int{1, 5, 6},
+			wantExtra:   []int{4},
+			wantMissing: []int{5, 6},
+		},
+		{
+			name: "both empty",
+			a:    nil,
+			b:    []int{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			extra, missing := elementsDiff(tt.a, tt.b)
+			AssertEqual(t, extra, tt.wantExtra)
+			AssertEqual(t, missing, tt.wantMissing)
+		})
+	}
+}
+
+func TestSubset(t *testing.T) {
+	AssertTrue(t, AssertSubset(t, []string{"b", "a"}, []string{"a", "b", "c"}))
+	AssertTrue(t, AssertSubset(t, nil, []string{"a"}))
+	AssertTrue(t, AssertElementsMatch(t, []string{"b", "a"}, []string{"a", "b"}))
+}
+
+func TestFirstUnsorted(t *testing.T) {
+	tests := []struct {
+		name string
+		s    []int
+		want int
+	}{
+		{name: "empty", s: nil, want: -1},
+		{name: "single", s: []int{1}, want: -1},
+		{name: "sorted", s: []int{1, 2, 2, 3}, want: -1},
+		{name: "unsorted", s: []int{1, 3, 2, 0}, want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, firstUnsorted(tt.s, cmp.Compare[int]), tt.want)
+		})
+	}
+
+	t.Run("descending comparator", func(t *testing.T) {
+		t.Parallel()
+		desc := func(a, b int) int { return cmp.Compare(b, a) }
+		AssertTrue(t, AssertSortedBy(t, []int{3, 2, 1}, desc))
+		AssertEqual(t, firstUnsorted([]int{3, 1, 2}, desc), 1)
+	})
+}
+
+func TestMoneyDifferences(t *testing.T) {
+	tests := []struct {
+		name string
+		got  Money
+		want Money
+		diff string
+	}{
+		{
+			name: "amount",
+			got:  NewUSD(100_98),
+			want: NewUSD(100_99),
+			diff: "amount differs: 10098 != 10099",
+		},
+		{
+			name: "currency",
+			got:  NewARS(100),
+			want: NewUSD(100),
+			diff: "currency differs: ARS != USD",
+		},
+		{
+			name: "amount and precision",
+			got:  Must(NewMoney(100_00, "USD", 2)),
+			want: Must(NewMoney(100_0000, "USD", 4)),
+			diff: "amount differs: 10000 != 1000000, precision differs: 2 != 4",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, moneyDifferences(tt.got, tt.want), tt.diff)
+		})
+	}
+
+	AssertTrue(t, AssertMoneyEqual(t, NewUSD(100), NewUSD(100)))
+}
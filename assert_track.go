@@ -0,0 +1,169 @@
+package pocket
+
+import (
+	"fmt"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+var (
+	trackersMu sync.Mutex
+	trackers   = map[string]*assertionTracker{}
+)
+
+type assertionTracker struct {
+	mu     sync.Mutex
+	counts map[string]*assertionCount
+}
+
+type assertionCount struct {
+	total  int
+	failed int
+}
+
+// TrackAssertions enables assertion counting for t and its subtests.
+// At cleanup, it logs a summary of the assertions that ran, grouped by assertion,
+// and fails the test if fewer than minAssertions ran. This catches table tests that silently assert nothing.
+//
+// Example:
+//
+//	func TestParse(t *testing.T) {
+//		pocket.TrackAssertions(t, len(tests))
+//		for _, tt := range tests { ... }
+//	}
+func TrackAssertions(t *testing.T, minAssertions int) {
+	t.Helper()
+
+	tracker := &assertionTracker{counts: map[string]*assertionCount{}}
+	name := t.Name()
+
+	trackersMu.Lock()
+	trackers[name] = tracker
+	trackersMu.Unlock()
+
+	t.Cleanup(func() {
+		trackersMu.Lock()
+		delete(trackers, name)
+		trackersMu.Unlock()
+
+		t.Log(tracker.summary())
+		if total, _ := tracker.totals(); total < minAssertions {
+			t.Errorf("expected at least %d assertions, but %d ran", minAssertions, total)
+		}
+	})
+}
+
+// pass records a successful assertion and returns true.
+func pass(t *testing.T) bool {
+	track(t, true)
+	return true
+}
+
+// track records the result of an assertion for the tracker of t or its closest tracked parent test.
+// The assertion is labeled with the name of the function that called pass or fail.
+func track(t *testing.T, passed bool) {
+	trackersMu.Lock()
+	if len(trackers) == 0 {
+		trackersMu.Unlock()
+		return
+	}
+	tracker := findTracker(t.Name())
+	trackersMu.Unlock()
+
+	if tracker == nil {
+		return
+	}
+
+	label := "unknown"
+	if pc, _, _, ok := runtime.Caller(2); ok {
+		if fn := runtime.FuncForPC(pc); fn != nil {
+			label = assertionLabel(fn.Name())
+		}
+	}
+
+	tracker.record(label, passed)
+}
+
+// findTracker must be called with trackersMu held.
+// Subtest names extend their parent's name with a "/", so parents are found by trimming the name.
+func findTracker(name string) *assertionTracker {
+	for {
+		if tracker, ok := trackers[name]; ok {
+			return tracker
+		}
+		i := strings.LastIndex(name, "/")
+		if i < 0 {
+			return nil
+		}
+		name = name[:i]
+	}
+}
+
+// assertionLabel turns a function name as reported by the runtime,
+// like "github.com/germanDV/pocket.AssertEqual[...]", into "AssertEqual".
+func assertionLabel(funcName string) string {
+	if i := strings.LastIndex(funcName, "/"); i >= 0 {
+		funcName = funcName[i+1:]
+	}
+	if i := strings.Index(funcName, "."); i >= 0 {
+		funcName = funcName[i+1:]
+	}
+	if i := strings.Index(funcName, "["); i >= 0 {
+		funcName = funcName[:i]
+	}
+	return funcName
+}
+
+func (a *assertionTracker) record(label string, passed bool) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	count, ok := a.counts[label]
+	if !ok {
+		count = &assertionCount{}
+		a.counts[label] = count
+	}
+	count.total++
+	if !passed {
+		count.failed++
+	}
+}
+
+func (a *assertionTracker) totals() (total int, failed int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, count := range a.counts {
+		total += count.total
+		failed += count.failed
+	}
+	return total, failed
+}
+
+func (a *assertionTracker) summary() string {
+	total, failed := a.totals()
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d assertion(s), %d failure(s)", total, failed)
+
+	labels := make([]string, 0, len(a.counts))
+	for label := range a.counts {
+		labels = append(labels, label)
+	}
+	slices.Sort(labels)
+
+	for _, label := range labels {
+		count := a.counts[label]
+		fmt.Fprintf(&b, "\n\t%s: %d", label, count.total)
+		if count.failed > 0 {
+			fmt.Fprintf(&b, " (%d failed)", count.failed)
+		}
+	}
+	return b.String()
+}
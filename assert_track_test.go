@@ -0,0 +1,51 @@
+package pocket
+
+import "testing"
+
+func TestAssertionLabel(t *testing.T) {
+	tests := []struct {
+		funcName string
+		want     string
+	}{
+		{funcName: "github.com/germanDV/pocket.AssertNil", want: "AssertNil"},
+		{funcName: "github.com/germanDV/pocket.AssertEqual[...]", want: "AssertEqual"},
+		{funcName: "github.com/germanDV/pocket.Subject.Contains", want: "Subject.Contains"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, assertionLabel(tt.funcName), tt.want)
+		})
+	}
+}
+
+func TestTrackAssertions(t *testing.T) {
+	TrackAssertions(t, 4)
+
+	AssertNil(t, nil)
+	AssertEqual(t, 1, 1)
+
+	t.Run("subtests count towards the parent", func(t *testing.T) {
+		AssertEqual(t, "a", "a")
+		AssertTrue(t, true)
+	})
+
+	trackersMu.Lock()
+	tracker := findTracker(t.Name() + "/subtest")
+	trackersMu.Unlock()
+
+	RequireNotNil(t, tracker)
+	summary := tracker.summary()
+	AssertEqual(t, summary, "5 assertion(s), 0 failure(s)\n\tAssertEqual: 2\n\tAssertNil: 1\n\tAssertNotNil: 1\n\tAssertTrue: 1")
+}
+
+func TestAssertionTrackerSummary(t *testing.T) {
+	tracker := &assertionTracker{counts: map[string]*assertionCount{}}
+	tracker.record("AssertNil", true)
+	tracker.record("AssertEqual", true)
+	tracker.record("AssertEqual", false)
+
+	want := "3 assertion(s), 1 failure(s)\n\tAssertEqual: 2 (1 failed)\n\tAssertNil: 1"
+	AssertEqual(t, tracker.summary(), want)
+}
@@ -0,0 +1,75 @@
+package pocket
+
+import "sync/atomic"
+
+// Atomic holds a value of any type that can be read and replaced atomically, e.g. a config snapshot
+// reloaded in the background while request handlers read it. Values are stored by copy, so treat
+// stored pointers, slices and maps as immutable.
+// The zero value holds the zero value of T and is ready to use. It must not be copied after first use.
+type Atomic[T any] struct {
+	p atomic.Pointer[T]
+}
+
+// NewAtomic returns an Atomic holding v.
+func NewAtomic[T any](v T) *Atomic[T] {
+	a := &Atomic[T]{}
+	a.Store(v)
+	return a
+}
+
+// Load returns the current value.
+func (a *Atomic[T]) Load() T {
+	if p := a.p.Load(); p != nil {
+		return *p
+	}
+	var zero T
+	return zero
+}
+
+// Store replaces the current value with v.
+func (a *Atomic[T]) Store(v T) {
+	a.p.Store(&v)
+}
+
+// Swap replaces the current value with v and returns the previous one.
+func (a *Atomic[T]) Swap(v T) T {
+	if p := a.p.Swap(&v); p != nil {
+		return *p
+	}
+	var zero T
+	return zero
+}
+
+// CompareAndSwap replaces the current value with new if it equals old, reporting whether it did.
+// It panics if T is not comparable.
+func (a *Atomic[T]) CompareAndSwap(old, new T) bool {
+	for {
+		p := a.p.Load()
+		var cur T
+		if p != nil {
+			cur = *p
+		}
+		if any(cur) != any(old) {
+			return false
+		}
+		if a.p.CompareAndSwap(p, &new) {
+			return true
+		}
+	}
+}
+
+// Update atomically replaces the current value with f applied to it, and returns the new value.
+// f may be called more than once under contention, so it must not have side effects.
+func (a *Atomic[T]) Update(f func(T) T) T {
+	for {
+		p := a.p.Load()
+		var cur T
+		if p != nil {
+			cur = *p
+		}
+		next := f(cur)
+		if a.p.CompareAndSwap(p, &next) {
+			return next
+		}
+	}
+}
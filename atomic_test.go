@@ -0,0 +1,65 @@
+package pocket
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestAtomic(t *testing.T) {
+	t.Run("zero value", func(t *testing.T) {
+		t.Parallel()
+		var a Atomic[string]
+		AssertEqual(t, a.Load(), "")
+		AssertEqual(t, a.Swap("x"), "")
+		AssertEqual(t, a.Load(), "x")
+	})
+
+	t.Run("load, store and swap", func(t *testing.T) {
+		t.Parallel()
+		type config struct {
+			Port  int
+			Debug bool
+		}
+		a := NewAtomic(config{Port: 8080})
+		AssertEqual(t, a.Load(), config{Port: 8080})
+
+		a.Store(config{Port: 9090, Debug: true})
+		AssertEqual(t, a.Load(), config{Port: 9090, Debug: true})
+
+		AssertEqual(t, a.Swap(config{}), config{Port: 9090, Debug: true})
+		AssertEqual(t, a.Load(), config{})
+	})
+
+	t.Run("compare and swap", func(t *testing.T) {
+		t.Parallel()
+		a := NewAtomic("v1")
+		AssertFalse(t, a.CompareAndSwap("v0", "v2"))
+		AssertEqual(t, a.Load(), "v1")
+		AssertTrue(t, a.CompareAndSwap("v1", "v2"))
+		AssertEqual(t, a.Load(), "v2")
+
+		var zero Atomic[int]
+		AssertTrue(t, zero.CompareAndSwap(0, 1))
+	})
+
+	t.Run("compare and swap on non-comparable type panics", func(t *testing.T) {
+		t.Parallel()
+		a := NewAtomic([]int{1})
+		AssertPanics(t, func() { a.CompareAndSwap([]int{1}, []int{2}) })
+	})
+
+	t.Run("concurrent updates", func(t *testing.T) {
+		t.Parallel()
+		var a Atomic[int]
+		var wg sync.WaitGroup
+		for range 50 {
+			wg.Go(func() {
+				for range 100 {
+					a.Update(func(n int) int { return n + 1 })
+				}
+			})
+		}
+		wg.Wait()
+		AssertEqual(t, a.Load(), 5000)
+	})
+}
@@ -0,0 +1,140 @@
+package pocket
+
+import (
+	"math"
+	mrand "math/rand/v2"
+	"sync"
+	"time"
+)
+
+// BackoffStop is returned by a Backoff to signal that no more attempts should be made.
+const BackoffStop time.Duration = -1
+
+// Backoff computes how long to wait before a retry.
+// Use one of the strategies below with RetryPolicy.Backoff, or on its own in hand-written loops.
+type Backoff interface {
+	// Next returns the delay before the given retry, starting at 1, or BackoffStop to give up.
+	Next(attempt int) time.Duration
+}
+
+// ConstantBackoff waits the same Delay before every retry.
+type ConstantBackoff struct {
+	Delay time.Duration
+}
+
+// Next returns b.Delay.
+func (b ConstantBackoff) Next(int) time.Duration {
+	return b.Delay
+}
+
+// LinearBackoff waits Initial before the first retry and Step longer before each of the next ones,
+// capped at Max if it is positive.
+type LinearBackoff struct {
+	Initial time.Duration
+	Step    time.Duration
+	Max     time.Duration
+}
+
+// Next returns Initial + Step*(attempt-1), capped at Max.
+func (b LinearBackoff) Next(attempt int) time.Duration {
+	return capDelay(float64(b.Initial)+float64(b.Step)*float64(max(attempt-1, 0)), b.Max)
+}
+
+// ExponentialBackoff waits Initial before the first retry and Multiplier times longer before each of the next ones,
+// capped at Max if it is positive.
+type ExponentialBackoff struct {
+	Initial time.Duration
+	Max     time.Duration
+	// Multiplier defaults to 2.
+	Multiplier float64
+	// Jitter randomly shortens each delay by up to this fraction (0 to 1),
+	// to spread out retries from many clients failing at once. Defaults to 0 (no jitter).
+	Jitter float64
+}
+
+// Next returns Initial * Multiplier^(attempt-1), capped at Max, minus the jitter.
+func (b ExponentialBackoff) Next(attempt int) time.Duration {
+	multiplier := b.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+	d := capDelay(float64(b.Initial)*math.Pow(multiplier, float64(max(attempt-1, 0))), b.Max)
+	if jitter := min(max(b.Jitter, 0), 1); jitter > 0 {
+		d -= time.Duration(mrand.Float64() * jitter * float64(d))
+	}
+	return d
+}
+
+// DecorrelatedJitterBackoff waits a random delay between Base and three times the previous delay, capped at Max,
+// as described in https://aws.amazon.com/blogs/architecture/exponential-backoff-and-jitter/.
+// It spreads out retries better than ExponentialBackoff with jitter, but depends on the previous delay,
+// so a sequence starts over when Next is called with attempt 1. Create it with NewDecorrelatedJitterBackoff.
+// It is safe for concurrent use, but concurrent retry loops should each have their own.
+type DecorrelatedJitterBackoff struct {
+	base, max time.Duration
+
+	mu   sync.Mutex
+	prev time.Duration
+}
+
+// NewDecorrelatedJitterBackoff returns a DecorrelatedJitterBackoff. A non-positive max means no cap.
+func NewDecorrelatedJitterBackoff(base, max time.Duration) *DecorrelatedJitterBackoff {
+	return &DecorrelatedJitterBackoff{base: base, max: max, prev: base}
+}
+
+// Next returns a random delay in [base, 3*previous delay), capped at max.
+func (b *DecorrelatedJitterBackoff) Next(attempt int) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if attempt <= 1 {
+		b.prev = b.base
+	}
+	upper := 3 * float64(b.prev)
+	d := float64(b.base)
+	if spread := upper - d; spread > 0 {
+		d += mrand.Float64() * spread
+	}
+	b.prev = capDelay(d, b.max)
+	return b.prev
+}
+
+// WithMaxElapsed wraps a Backoff so that it returns BackoffStop once the delays it returned would add up to more than max.
+// Like DecorrelatedJitterBackoff, it keeps track of a sequence that starts over when Next is called with attempt 1.
+func WithMaxElapsed(b Backoff, max time.Duration) Backoff {
+	return &maxElapsedBackoff{backoff: b, max: max}
+}
+
+type maxElapsedBackoff struct {
+	backoff Backoff
+	max     time.Duration
+
+	mu      sync.Mutex
+	elapsed time.Duration
+}
+
+func (b *maxElapsedBackoff) Next(attempt int) time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if attempt <= 1 {
+		b.elapsed = 0
+	}
+	d := b.backoff.Next(attempt)
+	if d < 0 || b.elapsed+d > b.max {
+		return BackoffStop
+	}
+	b.elapsed += d
+	return d
+}
+
+// capDelay converts d to a Duration no greater than limit (if positive), guarding against overflow.
+func capDelay(d float64, limit time.Duration) time.Duration {
+	if limit > 0 && d > float64(limit) {
+		return limit
+	}
+	if d >= math.MaxInt64 {
+		return math.MaxInt64
+	}
+	return time.Duration(d)
+}
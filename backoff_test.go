@@ -0,0 +1,110 @@
+package pocket
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// backoffDelays returns the first n delays of a Backoff.
+func backoffDelays(b Backoff, n int) []time.Duration {
+	out := make([]time.Duration, n)
+	for i := range out {
+		out[i] = b.Next(i + 1)
+	}
+	return out
+}
+
+func TestConstantBackoff(t *testing.T) {
+	t.Parallel()
+	AssertEqual(t, backoffDelays(ConstantBackoff{Delay: time.Second}, 3), []time.Duration{time.Second, time.Second, time.Second})
+}
+
+func TestLinearBackoff(t *testing.T) {
+	t.Parallel()
+	b := LinearBackoff{Initial: time.Second, Step: 2 * time.Second, Max: 6 * time.Second}
+	AssertEqual(t, backoffDelays(b, 5), []time.Duration{time.Second, 3 * time.Second, 5 * time.Second, 6 * time.Second, 6 * time.Second})
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("doubles by default", func(t *testing.T) {
+		t.Parallel()
+		b := ExponentialBackoff{Initial: 100 * time.Millisecond, Max: time.Second}
+		AssertEqual(t, backoffDelays(b, 6), []time.Duration{
+			100 * time.Millisecond, 200 * time.Millisecond, 400 * time.Millisecond, 800 * time.Millisecond, time.Second, time.Second,
+		})
+	})
+
+	t.Run("custom multiplier", func(t *testing.T) {
+		t.Parallel()
+		b := ExponentialBackoff{Initial: time.Second, Multiplier: 3}
+		AssertEqual(t, backoffDelays(b, 3), []time.Duration{time.Second, 3 * time.Second, 9 * time.Second})
+	})
+
+	t.Run("does not overflow", func(t *testing.T) {
+		t.Parallel()
+		b := ExponentialBackoff{Initial: time.Second}
+		AssertGreater(t, b.Next(1000), time.Duration(0))
+	})
+
+	t.Run("jitter shortens delays", func(t *testing.T) {
+		t.Parallel()
+		b := ExponentialBackoff{Initial: time.Second, Multiplier: 1, Jitter: 0.5}
+		ds := backoffDelays(b, 50)
+		for _, d := range ds {
+			AssertBetween(t, d, 500*time.Millisecond, time.Second)
+		}
+		AssertTrue(t, Any(ds, func(d time.Duration) bool { return d < time.Second }))
+	})
+}
+
+func TestDecorrelatedJitterBackoff(t *testing.T) {
+	t.Parallel()
+
+	t.Run("stays within bounds", func(t *testing.T) {
+		t.Parallel()
+		b := NewDecorrelatedJitterBackoff(100*time.Millisecond, 2*time.Second)
+		prev := 100 * time.Millisecond
+		for attempt := 1; attempt <= 50; attempt++ {
+			d := b.Next(attempt)
+			AssertBetween(t, d, 100*time.Millisecond, min(3*prev, 2*time.Second))
+			prev = d
+		}
+	})
+
+	t.Run("starts over at attempt 1", func(t *testing.T) {
+		t.Parallel()
+		b := NewDecorrelatedJitterBackoff(time.Second, time.Hour)
+		for attempt := 1; attempt <= 20; attempt++ {
+			b.Next(attempt)
+		}
+		AssertLessOrEqual(t, b.Next(1), 3*time.Second)
+	})
+
+	t.Run("safe for concurrent use", func(t *testing.T) {
+		t.Parallel()
+		b := NewDecorrelatedJitterBackoff(time.Millisecond, time.Second)
+		var wg sync.WaitGroup
+		for range 4 {
+			wg.Go(func() { backoffDelays(b, 100) })
+		}
+		wg.Wait()
+	})
+}
+
+func TestWithMaxElapsed(t *testing.T) {
+	t.Parallel()
+	b := WithMaxElapsed(ConstantBackoff{Delay: time.Second}, 3*time.Second)
+	AssertEqual(t, backoffDelays(b, 5), []time.Duration{time.Second, time.Second, time.Second, BackoffStop, BackoffStop})
+
+	// A new sequence starts over.
+	AssertEqual(t, b.Next(1), time.Second)
+
+	t.Run("propagates stop", func(t *testing.T) {
+		t.Parallel()
+		inner := WithMaxElapsed(ConstantBackoff{Delay: time.Second}, time.Second)
+		AssertEqual(t, backoffDelays(WithMaxElapsed(inner, time.Hour), 2), []time.Duration{time.Second, BackoffStop})
+	})
+}
@@ -0,0 +1,196 @@
+package pocket
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrBatcherStopped is returned when adding to or flushing a Batcher that has been stopped.
+var ErrBatcherStopped = errors.New("batcher stopped")
+
+// BatcherConfig configures a Batcher. Zero fields take the defaults noted on each one.
+type BatcherConfig[T any] struct {
+	// MaxSize is the number of items that triggers a flush. Defaults to 100.
+	MaxSize int
+	// MaxWait is how long the first item of a batch may wait before the batch is flushed. Defaults to 1s.
+	MaxWait time.Duration
+	// Buffer is how many added items may wait for the flush loop before Add blocks. Defaults to MaxSize.
+	Buffer int
+	// OnError, if set, is called with the error and items of every failed automatic flush.
+	// Flushes triggered by Flush and Stop return their error instead.
+	OnError func(err error, items []T)
+	// Clock is used to time MaxWait. Defaults to RealClock; use a FakeClock in tests.
+	Clock Clock
+}
+
+func (c BatcherConfig[T]) withDefaults() BatcherConfig[T] {
+	if c.MaxSize <= 0 {
+		c.MaxSize = 100
+	}
+	if c.MaxWait <= 0 {
+		c.MaxWait = time.Second
+	}
+	if c.Buffer <= 0 {
+		c.Buffer = c.MaxSize
+	}
+	if c.Clock == nil {
+		c.Clock = RealClock{}
+	}
+	return c
+}
+
+// Batcher accumulates items and hands them to a flush function in batches,
+// when MaxSize items are pending or the oldest one has waited MaxWait, whichever comes first.
+// It is the usual building block for bulk database writes and event shipping.
+// Add blocks once the buffer is full, so a slow flush function slows producers down instead of growing memory.
+// It is safe for concurrent use.
+type Batcher[T any] struct {
+	cfg   BatcherConfig[T]
+	flush func(ctx context.Context, items []T) error
+
+	mu      sync.RWMutex
+	stopped bool
+
+	items    chan T
+	flushReq chan batcherFlush
+	stop     chan batcherFlush
+	done     chan struct{}
+	stopErr  error
+}
+
+type batcherFlush struct {
+	ctx  context.Context
+	errc chan error
+}
+
+// NewBatcher returns a running Batcher that calls flush with every batch. Calls to flush never overlap.
+// Call Stop to flush the remaining items and release its goroutine.
+func NewBatcher[T any](flush func(ctx context.Context, items []T) error, cfg BatcherConfig[T]) *Batcher[T] {
+	cfg = cfg.withDefaults()
+	b := &Batcher[T]{
+		cfg:      cfg,
+		flush:    flush,
+		items:    make(chan T, cfg.Buffer),
+		flushReq: make(chan batcherFlush),
+		stop:     make(chan batcherFlush, 1),
+		done:     make(chan struct{}),
+	}
+	go b.loop()
+	return b
+}
+
+// Add queues an item for the next batch, blocking while the buffer is full.
+// Returns ErrBatcherStopped after Stop, or ctx's error if it is done while blocked.
+func (b *Batcher[T]) Add(ctx context.Context, item T) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.stopped {
+		return ErrBatcherStopped
+	}
+
+	select {
+	case b.items <- item:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Flush immediately flushes the pending items, including every item added before the call,
+// and returns the flush function's error.
+func (b *Batcher[T]) Flush(ctx context.Context) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	if b.stopped {
+		return ErrBatcherStopped
+	}
+
+	req := batcherFlush{ctx: ctx, errc: make(chan error, 1)}
+	select {
+	case b.flushReq <- req:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return <-req.errc
+}
+
+// Stop stops accepting items, flushes the pending ones and waits for the flush loop to exit.
+// It returns the final flush's error, or ctx's error if it is done before the loop exits.
+// Calling Stop more than once returns the result of the first call.
+func (b *Batcher[T]) Stop(ctx context.Context) error {
+	b.mu.Lock()
+	first := !b.stopped
+	b.stopped = true
+	b.mu.Unlock()
+
+	if first {
+		b.stop <- batcherFlush{ctx: ctx}
+	}
+	select {
+	case <-b.done:
+		return b.stopErr
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (b *Batcher[T]) loop() {
+	defer close(b.done)
+
+	var pending []T
+	var deadline <-chan time.Time
+
+	flush := func(ctx context.Context) error {
+		deadline = nil
+		if len(pending) == 0 {
+			return nil
+		}
+		items := pending
+		pending = nil
+		return b.flush(ctx, items)
+	}
+	autoFlush := func() {
+		items := pending
+		if err := flush(context.Background()); err != nil && b.cfg.OnError != nil {
+			b.cfg.OnError(err, items)
+		}
+	}
+	add := func(item T) {
+		pending = append(pending, item)
+		if len(pending) == 1 {
+			deadline = b.cfg.Clock.After(b.cfg.MaxWait)
+		}
+		if len(pending) >= b.cfg.MaxSize {
+			autoFlush()
+		}
+	}
+	// drain moves the items already buffered into pending, so explicit flushes include them.
+	drain := func() {
+		for {
+			select {
+			case item := <-b.items:
+				add(item)
+			default:
+				return
+			}
+		}
+	}
+
+	for {
+		select {
+		case item := <-b.items:
+			add(item)
+		case <-deadline:
+			autoFlush()
+		case req := <-b.flushReq:
+			drain()
+			req.errc <- flush(req.ctx)
+		case req := <-b.stop:
+			drain()
+			b.stopErr = flush(req.ctx)
+			return
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package pocket
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// batchRecorder collects the batches passed to a Batcher's flush function.
+type batchRecorder struct {
+	mu      sync.Mutex
+	batches [][]int
+	err     error
+}
+
+func (r *batchRecorder) flush(_ context.Context, items []int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.batches = append(r.batches, items)
+	return r.err
+}
+
+func (r *batchRecorder) get() [][]int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.batches
+}
+
+func TestBatcher(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("flushes when full", func(t *testing.T) {
+		t.Parallel()
+		var r batchRecorder
+		b := NewBatcher(r.flush, BatcherConfig[int]{MaxSize: 3, MaxWait: time.Hour})
+		for i := range 7 {
+			AssertNil(t, b.Add(ctx, i))
+		}
+		RequireEventually(t, func() bool { return len(r.get()) == 2 }, time.Second, time.Millisecond)
+		AssertEqual(t, r.get(), [][]int{{0, 1, 2}, {3, 4, 5}})
+
+		AssertNil(t, b.Stop(ctx))
+		AssertEqual(t, r.get(), [][]int{{0, 1, 2}, {3, 4, 5}, {6}})
+	})
+
+	t.Run("flushes after max wait", func(t *testing.T) {
+		t.Parallel()
+		var r batchRecorder
+		clock := NewFakeClock(epoch)
+		b := NewBatcher(r.flush, BatcherConfig[int]{MaxSize: 10, MaxWait: time.Second, Clock: clock})
+		defer b.Stop(ctx)
+
+		AssertNil(t, b.Add(ctx, 1))
+		AssertNil(t, b.Add(ctx, 2))
+		clock.BlockUntil(1)
+		AssertEmpty(t, r.get())
+
+		clock.Advance(time.Second)
+		RequireEventually(t, func() bool { return len(r.get()) == 1 }, time.Second, time.Millisecond)
+		AssertEqual(t, r.get(), [][]int{{1, 2}})
+	})
+
+	t.Run("explicit flush includes every added item", func(t *testing.T) {
+		t.Parallel()
+		var r batchRecorder
+		b := NewBatcher(r.flush, BatcherConfig[int]{MaxSize: 10, MaxWait: time.Hour})
+		defer b.Stop(ctx)
+
+		AssertNil(t, b.Add(ctx, 1))
+		AssertNil(t, b.Add(ctx, 2))
+		AssertNil(t, b.Flush(ctx))
+		AssertEqual(t, r.get(), [][]int{{1, 2}})
+
+		AssertNil(t, b.Flush(ctx))
+		AssertEqual(t, len(r.get()), 1)
+	})
+
+	t.Run("flush returns the error", func(t *testing.T) {
+		t.Parallel()
+		errDB := errors.New("db down")
+		r := batchRecorder{err: errDB}
+		b := NewBatcher(r.flush, BatcherConfig[int]{})
+
+		AssertNil(t, b.Add(ctx, 1))
+		AssertErrorIs(t, b.Flush(ctx), errDB)
+		AssertNil(t, b.Add(ctx, 2))
+		AssertErrorIs(t, b.Stop(ctx), errDB)
+	})
+
+	t.Run("automatic flush errors go to OnError", func(t *testing.T) {
+		t.Parallel()
+		errDB := errors.New("db down")
+		r := batchRecorder{err: errDB}
+		failed := make(chan []int, 1)
+		b := NewBatcher(r.flush, BatcherConfig[int]{
+			MaxSize: 2,
+			OnError: func(err error, items []int) {
+				AssertErrorIs(t, err, errDB)
+				failed <- items
+			},
+		})
+		defer b.Stop(ctx)
+
+		AssertNil(t, b.Add(ctx, 1))
+		AssertNil(t, b.Add(ctx, 2))
+		AssertEqual(t, <-failed, []int{1, 2})
+	})
+
+	t.Run("add blocks when the buffer is full", func(t *testing.T) {
+		t.Parallel()
+		release := make(chan struct{})
+		b := NewBatcher(func(context.Context, []int) error {
+			<-release
+			return nil
+		}, BatcherConfig[int]{MaxSize: 1, Buffer: 1})
+
+		AssertNil(t, b.Add(ctx, 1)) // being flushed
+		AssertNil(t, b.Add(ctx, 2)) // buffered
+
+		timeout, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer cancel()
+		var err error
+		for err == nil {
+			err = b.Add(timeout, 3)
+		}
+		AssertErrorIs(t, err, context.DeadlineExceeded)
+
+		close(release)
+		AssertNil(t, b.Stop(ctx))
+	})
+
+	t.Run("stopped", func(t *testing.T) {
+		t.Parallel()
+		var r batchRecorder
+		b := NewBatcher(r.flush, BatcherConfig[int]{})
+		AssertNil(t, b.Stop(ctx))
+		AssertNil(t, b.Stop(ctx))
+		AssertErrorIs(t, b.Add(ctx, 1), ErrBatcherStopped)
+		AssertErrorIs(t, b.Flush(ctx), ErrBatcherStopped)
+		AssertEmpty(t, r.get())
+	})
+}
@@ -0,0 +1,156 @@
+package pocket
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Merge forwards the values from every input channel to a single output channel.
+// The output is closed once all inputs are closed or ctx is done. Order across inputs is not preserved.
+func Merge[T any](ctx context.Context, chs ...<-chan T) <-chan T {
+	out := make(chan T)
+	var wg sync.WaitGroup
+	for _, ch := range chs {
+		wg.Go(func() {
+			forward(ctx, ch, out)
+		})
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// FanOut distributes the values from ch across n output channels, for n workers to consume.
+// Each value goes to exactly one output, whichever is ready first.
+// The outputs are closed once ch is closed or ctx is done. It panics if n < 1.
+func FanOut[T any](ctx context.Context, ch <-chan T, n int) []<-chan T {
+	if n < 1 {
+		panic(fmt.Sprintf("fan out needs at least one output, got %d", n))
+	}
+
+	outs := make([]<-chan T, n)
+	for i := range outs {
+		out := make(chan T)
+		outs[i] = out
+		go func() {
+			defer close(out)
+			forward(ctx, ch, out)
+		}()
+	}
+	return outs
+}
+
+// BatchOption configures Batch.
+type BatchOption func(*batchConfig)
+
+type batchConfig struct {
+	clock Clock
+}
+
+// WithBatchClock sets the Clock used to time maxWait, e.g. a FakeClock in tests. Defaults to RealClock.
+func WithBatchClock(clock Clock) BatchOption {
+	return func(c *batchConfig) {
+		c.clock = clock
+	}
+}
+
+// Batch groups the values from ch into slices of up to size elements.
+// A batch is emitted when it is full or maxWait after its first value arrived, whichever comes first,
+// so slow producers don't hold values back indefinitely. A maxWait <= 0 only emits full batches.
+// When ch is closed, the last partial batch is emitted and the output is closed. If ctx is done, the pending batch is dropped.
+// It panics if size < 1.
+func Batch[T any](ctx context.Context, ch <-chan T, size int, maxWait time.Duration, opts ...BatchOption) <-chan []T {
+	if size < 1 {
+		panic(fmt.Sprintf("batch size must be at least 1, got %d", size))
+	}
+	cfg := batchConfig{clock: RealClock{}}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	out := make(chan []T)
+	go func() {
+		defer close(out)
+
+		var batch []T
+		var deadline <-chan time.Time
+		flush := func() bool {
+			deadline = nil
+			b := batch
+			batch = nil
+			return send(ctx, out, b)
+		}
+
+		for {
+			select {
+			case v, ok := <-ch:
+				if !ok {
+					if len(batch) > 0 {
+						flush()
+					}
+					return
+				}
+				batch = append(batch, v)
+				if len(batch) == 1 && maxWait > 0 {
+					deadline = cfg.clock.After(maxWait)
+				}
+				if len(batch) == size && !flush() {
+					return
+				}
+			case <-deadline:
+				deadline = nil
+				if !flush() {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Drain discards values from ch until it is closed or ctx is done, and returns how many it discarded.
+// Useful to unblock the producer of a channel whose values are no longer needed.
+func Drain[T any](ctx context.Context, ch <-chan T) int {
+	n := 0
+	for {
+		select {
+		case _, ok := <-ch:
+			if !ok {
+				return n
+			}
+			n++
+		case <-ctx.Done():
+			return n
+		}
+	}
+}
+
+// forward copies values from in to out until in is closed or ctx is done.
+func forward[T any](ctx context.Context, in <-chan T, out chan<- T) {
+	for {
+		select {
+		case v, ok := <-in:
+			if !ok || !send(ctx, out, v) {
+				return
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// send delivers v on ch, reporting false if ctx was done first.
+func send[T any](ctx context.Context, ch chan<- T, v T) bool {
+	select {
+	case ch <- v:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
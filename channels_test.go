@@ -0,0 +1,177 @@
+package pocket
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+)
+
+func feed[T any](values ...T) <-chan T {
+	ch := make(chan T, len(values))
+	for _, v := range values {
+		ch <- v
+	}
+	close(ch)
+	return ch
+}
+
+func collect[T any](ch <-chan T) []T {
+	var out []T
+	for v := range ch {
+		out = append(out, v)
+	}
+	return out
+}
+
+func TestMerge(t *testing.T) {
+	t.Run("merges all values", func(t *testing.T) {
+		t.Parallel()
+		got := collect(Merge(context.Background(), feed(1, 2, 3), feed(4, 5), feed[int]()))
+		AssertElementsMatch(t, got, []int{1, 2, 3, 4, 5})
+	})
+
+	t.Run("no inputs", func(t *testing.T) {
+		t.Parallel()
+		AssertEmpty(t, collect(Merge[int](context.Background())))
+	})
+
+	t.Run("stops when ctx is done", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithCancel(context.Background())
+		open := make(chan int)
+		out := Merge(ctx, open)
+		cancel()
+
+		select {
+		case _, ok := <-out:
+			AssertFalse(t, ok)
+		case <-time.After(time.Second):
+			t.Error("output was not closed")
+		}
+	})
+}
+
+func TestFanOut(t *testing.T) {
+	t.Run("each value goes to one output", func(t *testing.T) {
+		t.Parallel()
+		in := make(chan int)
+		go func() {
+			defer close(in)
+			for i := range 100 {
+				in <- i
+			}
+		}()
+
+		var mu sync.Mutex
+		var got []int
+		var wg sync.WaitGroup
+		for _, out := range FanOut(context.Background(), in, 4) {
+			wg.Go(func() {
+				for v := range out {
+					mu.Lock()
+					got = append(got, v)
+					mu.Unlock()
+				}
+			})
+		}
+		wg.Wait()
+
+		slices.Sort(got)
+		AssertEqual(t, got, RangeInt(0, 100, 1))
+	})
+
+	t.Run("stops when ctx is done", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithCancel(context.Background())
+		outs := FanOut(ctx, make(chan int), 2)
+		cancel()
+		for _, out := range outs {
+			AssertEmpty(t, collect(out))
+		}
+	})
+
+	t.Run("invalid n panics", func(t *testing.T) {
+		t.Parallel()
+		AssertPanicsWith(t, func() { FanOut(context.Background(), feed(1), 0) }, "fan out needs at least one output, got 0")
+	})
+}
+
+func TestBatch(t *testing.T) {
+	t.Run("groups by size and flushes the remainder", func(t *testing.T) {
+		t.Parallel()
+		got := collect(Batch(context.Background(), feed(1, 2, 3, 4, 5), 2, 0))
+		AssertEqual(t, got, [][]int{{1, 2}, {3, 4}, {5}})
+	})
+
+	t.Run("flushes after max wait", func(t *testing.T) {
+		t.Parallel()
+		in := make(chan int)
+		out := Batch(context.Background(), in, 10, 10*time.Millisecond)
+		in <- 1
+		in <- 2
+
+		select {
+		case b := <-out:
+			AssertEqual(t, b, []int{1, 2})
+		case <-time.After(time.Second):
+			t.Fatal("partial batch was not flushed")
+		}
+
+		in <- 3
+		close(in)
+		AssertEqual(t, collect(out), [][]int{{3}})
+	})
+
+	t.Run("flushes after max wait on the given clock", func(t *testing.T) {
+		t.Parallel()
+		clock := NewFakeClock(epoch)
+		in := make(chan int)
+		out := Batch(context.Background(), in, 10, time.Minute, WithBatchClock(clock))
+		in <- 1
+		in <- 2
+
+		clock.BlockUntil(1)
+		clock.Advance(59 * time.Second)
+		select {
+		case b := <-out:
+			t.Fatalf("batch %v flushed before max wait", b)
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		clock.Advance(time.Second)
+		AssertEqual(t, <-out, []int{1, 2})
+		close(in)
+		AssertEmpty(t, collect(out))
+	})
+
+	t.Run("stops when ctx is done", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithCancel(context.Background())
+		in := make(chan int, 1)
+		in <- 1
+		out := Batch(ctx, in, 10, 0)
+		cancel()
+		AssertEmpty(t, collect(out))
+	})
+
+	t.Run("invalid size panics", func(t *testing.T) {
+		t.Parallel()
+		AssertPanicsWith(t, func() { Batch(context.Background(), feed(1), 0, time.Second) }, "batch size must be at least 1, got 0")
+	})
+}
+
+func TestDrain(t *testing.T) {
+	t.Run("discards until closed", func(t *testing.T) {
+		t.Parallel()
+		AssertEqual(t, Drain(context.Background(), feed("a", "b", "c")), 3)
+	})
+
+	t.Run("stops when ctx is done", func(t *testing.T) {
+		t.Parallel()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		defer cancel()
+		AssertEqual(t, Drain(ctx, make(chan int)), 0)
+	})
+}
@@ -0,0 +1,251 @@
+package pocket
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Clock abstracts time so that time-dependent code can be tested deterministically.
+// Use RealClock in production and FakeClock in tests.
+//
+// Every time-dependent feature in this package accepts a Clock:
+// RetryPolicy.Clock, BatcherConfig.Clock, WithBatchClock, WithShutdownClock, WithLRUClock, WithMemoizeClock,
+// WithEveryClock, NewULIDSource, WithIDClock, NewStopwatchWithClock, TimeFuncWithClock, SleepCtxWithClock,
+// TouchWithClock and AssertEventuallyWithClock/AssertNeverWithClock.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// Sleep pauses the current goroutine for at least the duration d.
+	Sleep(d time.Duration)
+	// After waits for the duration to elapse and then sends the current time on the returned channel.
+	After(d time.Duration) <-chan time.Time
+	// NewTicker returns a Ticker that sends the current time on its channel every d.
+	// It panics if d <= 0.
+	NewTicker(d time.Duration) Ticker
+}
+
+// Ticker is the Clock counterpart of time.Ticker.
+type Ticker interface {
+	// C returns the channel on which the ticks are delivered.
+	C() <-chan time.Time
+	// Stop turns off the ticker. No more ticks will be sent after Stop returns.
+	Stop()
+	// Reset stops the ticker and resets its period to d.
+	Reset(d time.Duration)
+}
+
+// RealClock is a Clock backed by the time package.
+type RealClock struct{}
+
+// Now returns time.Now().
+func (RealClock) Now() time.Time {
+	return time.Now()
+}
+
+// Sleep calls time.Sleep.
+func (RealClock) Sleep(d time.Duration) {
+	time.Sleep(d)
+}
+
+// After calls time.After.
+func (RealClock) After(d time.Duration) <-chan time.Time {
+	return time.After(d)
+}
+
+// NewTicker wraps time.NewTicker.
+func (RealClock) NewTicker(d time.Duration) Ticker {
+	return realTicker{time.NewTicker(d)}
+}
+
+type realTicker struct {
+	ticker *time.Ticker
+}
+
+func (r realTicker) C() <-chan time.Time {
+	return r.ticker.C
+}
+
+func (r realTicker) Stop() {
+	r.ticker.Stop()
+}
+
+func (r realTicker) Reset(d time.Duration) {
+	r.ticker.Reset(d)
+}
+
+// FakeClock is a Clock whose time only moves when told to, via Advance or SetTime.
+// Sleepers, After channels and tickers fire when the fake time reaches their deadline.
+// It is safe for concurrent use.
+type FakeClock struct {
+	mu      sync.Mutex
+	cond    *sync.Cond
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+type fakeWaiter struct {
+	deadline time.Time
+	period   time.Duration // Only set for tickers.
+	ch       chan time.Time
+}
+
+// NewFakeClock returns a FakeClock set to the given time.
+func NewFakeClock(now time.Time) *FakeClock {
+	c := &FakeClock{now: now}
+	c.cond = sync.NewCond(&c.mu)
+	return c
+}
+
+// Now returns the fake current time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Sleep blocks until the fake time has advanced by at least d.
+func (c *FakeClock) Sleep(d time.Duration) {
+	<-c.After(d)
+}
+
+// After returns a channel that receives the fake time once it has advanced by at least d.
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+
+	c.addWaiter(&fakeWaiter{deadline: c.now.Add(d), ch: ch})
+	return ch
+}
+
+// NewTicker returns a Ticker that ticks every time the fake time advances by d.
+// Like time.Ticker, it drops ticks for slow receivers.
+func (c *FakeClock) NewTicker(d time.Duration) Ticker {
+	if d <= 0 {
+		panic("non-positive interval for FakeClock.NewTicker")
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	w := &fakeWaiter{deadline: c.now.Add(d), period: d, ch: make(chan time.Time, 1)}
+	c.addWaiter(w)
+	return &fakeTicker{clock: c, waiter: w}
+}
+
+// Advance moves the fake time forward by d, firing any waiter whose deadline is reached.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setTimeLocked(c.now.Add(d))
+}
+
+// SetTime sets the fake time, firing any waiter whose deadline is reached.
+// Moving the time backwards does not fire anything.
+func (c *FakeClock) SetTime(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.setTimeLocked(now)
+}
+
+// setTimeLocked must be called with c.mu held.
+func (c *FakeClock) setTimeLocked(now time.Time) {
+	c.now = now
+
+	pending := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.deadline.After(now) {
+			pending = append(pending, w)
+			continue
+		}
+
+		select {
+		case w.ch <- now:
+		default:
+		}
+
+		if w.period > 0 {
+			for !w.deadline.After(now) {
+				w.deadline = w.deadline.Add(w.period)
+			}
+			pending = append(pending, w)
+		}
+	}
+	c.waiters = pending
+	c.sortWaiters()
+}
+
+// Waiters returns the number of pending sleepers, After channels and active tickers.
+func (c *FakeClock) Waiters() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
+// BlockUntil blocks until there are at least n pending waiters.
+// Use it to make sure the code under test is waiting on the clock before calling Advance.
+func (c *FakeClock) BlockUntil(n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.waiters) < n {
+		c.cond.Wait()
+	}
+}
+
+// addWaiter must be called with c.mu held.
+func (c *FakeClock) addWaiter(w *fakeWaiter) {
+	c.waiters = append(c.waiters, w)
+	c.sortWaiters()
+	c.cond.Broadcast()
+}
+
+// sortWaiters keeps waiters ordered by deadline so they fire in order. It must be called with c.mu held.
+func (c *FakeClock) sortWaiters() {
+	slices.SortStableFunc(c.waiters, func(a, b *fakeWaiter) int {
+		return a.deadline.Compare(b.deadline)
+	})
+}
+
+// removeWaiter must be called with c.mu held.
+func (c *FakeClock) removeWaiter(w *fakeWaiter) {
+	for i, existing := range c.waiters {
+		if existing == w {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			return
+		}
+	}
+}
+
+type fakeTicker struct {
+	clock  *FakeClock
+	waiter *fakeWaiter
+}
+
+func (f *fakeTicker) C() <-chan time.Time {
+	return f.waiter.ch
+}
+
+func (f *fakeTicker) Stop() {
+	f.clock.mu.Lock()
+	defer f.clock.mu.Unlock()
+	f.clock.removeWaiter(f.waiter)
+}
+
+func (f *fakeTicker) Reset(d time.Duration) {
+	if d <= 0 {
+		panic("non-positive interval for FakeClock ticker Reset")
+	}
+
+	f.clock.mu.Lock()
+	defer f.clock.mu.Unlock()
+	f.clock.removeWaiter(f.waiter)
+	f.waiter.period = d
+	f.waiter.deadline = f.clock.now.Add(d)
+	f.clock.addWaiter(f.waiter)
+}
@@ -0,0 +1,125 @@
+package pocket
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+var epoch = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestRealClock(t *testing.T) {
+	clock := RealClock{}
+
+	before := time.Now()
+	AssertFalse(t, clock.Now().Before(before))
+
+	<-clock.After(time.Millisecond)
+
+	ticker := clock.NewTicker(time.Millisecond)
+	defer ticker.Stop()
+	<-ticker.C()
+}
+
+func TestFakeClock(t *testing.T) {
+	t.Run("now only moves when told to", func(t *testing.T) {
+		clock := NewFakeClock(epoch)
+		AssertEqual(t, clock.Now(), epoch)
+
+		clock.Advance(time.Hour)
+		AssertEqual(t, clock.Now(), epoch.Add(time.Hour))
+
+		later := epoch.Add(24 * time.Hour)
+		clock.SetTime(later)
+		AssertEqual(t, clock.Now(), later)
+	})
+
+	t.Run("after fires at deadline", func(t *testing.T) {
+		clock := NewFakeClock(epoch)
+		ch := clock.After(time.Minute)
+		AssertEqual(t, clock.Waiters(), 1)
+
+		clock.Advance(59 * time.Second)
+		select {
+		case <-ch:
+			t.Fatal("fired before deadline")
+		default:
+		}
+
+		clock.Advance(time.Second)
+		AssertEqual(t, <-ch, epoch.Add(time.Minute))
+		AssertEqual(t, clock.Waiters(), 0)
+	})
+
+	t.Run("after with non-positive duration fires immediately", func(t *testing.T) {
+		clock := NewFakeClock(epoch)
+		AssertEqual(t, <-clock.After(0), epoch)
+	})
+
+	t.Run("sleep wakes up on advance", func(t *testing.T) {
+		clock := NewFakeClock(epoch)
+		done := make(chan struct{})
+		go func() {
+			clock.Sleep(time.Second)
+			close(done)
+		}()
+
+		clock.BlockUntil(1)
+		clock.Advance(time.Second)
+		<-done
+	})
+
+	t.Run("ticker", func(t *testing.T) {
+		clock := NewFakeClock(epoch)
+		ticker := clock.NewTicker(10 * time.Second)
+
+		clock.Advance(10 * time.Second)
+		AssertEqual(t, <-ticker.C(), epoch.Add(10*time.Second))
+
+		clock.Advance(10 * time.Second)
+		AssertEqual(t, <-ticker.C(), epoch.Add(20*time.Second))
+
+		ticker.Reset(time.Minute)
+		clock.Advance(10 * time.Second)
+		select {
+		case <-ticker.C():
+			t.Fatal("ticked before the new period")
+		default:
+		}
+
+		ticker.Stop()
+		AssertEqual(t, clock.Waiters(), 0)
+	})
+
+	t.Run("ticker panics on non-positive interval", func(t *testing.T) {
+		AssertPanics(t, func() { NewFakeClock(epoch).NewTicker(0) })
+	})
+
+	t.Run("concurrent advances all apply", func(t *testing.T) {
+		clock := NewFakeClock(epoch)
+		var wg sync.WaitGroup
+		for range 100 {
+			wg.Go(func() { clock.Advance(time.Second) })
+		}
+		wg.Wait()
+		AssertEqual(t, clock.Now(), epoch.Add(100*time.Second))
+	})
+}
+
+func TestAssertEventuallyWithFakeClock(t *testing.T) {
+	clock := NewFakeClock(epoch)
+	var ticks atomic.Int32
+
+	ticker := clock.NewTicker(time.Minute)
+	defer ticker.Stop()
+	go func() {
+		for range ticker.C() {
+			ticks.Add(1)
+		}
+	}()
+
+	// An hour of fake time passes almost instantly.
+	AssertEventuallyWithClock(t, clock, func() bool { return ticks.Load() >= 3 }, time.Hour, time.Minute)
+	AssertNeverWithClock(t, clock, func() bool { return ticks.Load() < 0 }, 5*time.Minute, time.Minute)
+}
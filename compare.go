@@ -0,0 +1,42 @@
+package pocket
+
+import "cmp"
+
+// CompareBy returns a comparator ordering values by the key returned by the given function,
+// for use with SortByChain, slices.SortFunc, NewPriorityQueue or AssertSortedBy.
+//
+// Example:
+//
+//	byAge := pocket.CompareBy(func(p Person) int { return p.Age })
+func CompareBy[T any, K cmp.Ordered](key func(T) K) func(a, b T) int {
+	return func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	}
+}
+
+// Then combines comparators: values are ordered by the first one, ties are broken by the second one, and so on.
+// With no comparators, every value compares equal.
+//
+// Example:
+//
+//	byName := pocket.Then(
+//		pocket.CompareBy(func(p Person) string { return p.LastName }),
+//		pocket.CompareBy(func(p Person) string { return p.FirstName }),
+//	)
+func Then[T any](compares ...func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		for _, compare := range compares {
+			if c := compare(a, b); c != 0 {
+				return c
+			}
+		}
+		return 0
+	}
+}
+
+// Descending returns a comparator with the reverse order of the given one.
+func Descending[T any](compare func(a, b T) int) func(a, b T) int {
+	return func(a, b T) int {
+		return compare(b, a)
+	}
+}
@@ -0,0 +1,59 @@
+package pocket
+
+import (
+	"slices"
+	"testing"
+)
+
+type comparePerson struct {
+	Name string
+	Age  int
+}
+
+func TestCompareBy(t *testing.T) {
+	t.Parallel()
+	byAge := CompareBy(func(p comparePerson) int { return p.Age })
+
+	AssertEqual(t, byAge(comparePerson{Age: 30}, comparePerson{Age: 40}), -1)
+	AssertEqual(t, byAge(comparePerson{Age: 40}, comparePerson{Age: 30}), 1)
+	AssertEqual(t, byAge(comparePerson{Name: "a", Age: 30}, comparePerson{Name: "b", Age: 30}), 0)
+}
+
+func TestDescending(t *testing.T) {
+	t.Parallel()
+	desc := Descending(CompareBy(func(n int) int { return n }))
+
+	nums := []int{3, 1, 2}
+	slices.SortFunc(nums, desc)
+	AssertEqual(t, nums, []int{3, 2, 1})
+	AssertSortedBy(t, nums, desc)
+}
+
+func TestThen(t *testing.T) {
+	t.Parallel()
+	people := []comparePerson{{"Cy", 30}, {"Ana", 40}, {"Bo", 30}, {"Di", 40}}
+	byAgeDescThenName := Then(
+		Descending(CompareBy(func(p comparePerson) int { return p.Age })),
+		CompareBy(func(p comparePerson) string { return p.Name }),
+	)
+
+	slices.SortFunc(people, byAgeDescThenName)
+	AssertEqual(t, people, []comparePerson{{"Ana", 40}, {"Di", 40}, {"Bo", 30}, {"Cy", 30}})
+
+	t.Run("no comparators", func(t *testing.T) {
+		t.Parallel()
+		AssertEqual(t, Then[int]()(1, 2), 0)
+	})
+
+	t.Run("with priority queue", func(t *testing.T) {
+		t.Parallel()
+		pq := NewPriorityQueue(byAgeDescThenName)
+		pq.Push(comparePerson{"Bo", 30})
+		pq.Push(comparePerson{"Di", 40})
+		pq.Push(comparePerson{"Ana", 40})
+
+		first, ok := pq.Pop()
+		AssertTrue(t, ok)
+		AssertEqual(t, first, comparePerson{"Ana", 40})
+	})
+}
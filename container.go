@@ -0,0 +1,91 @@
+package pocket
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Container wires small applications without a dependency injection framework:
+// constructors are registered by name with Provide and run lazily, once, the first time they are resolved.
+// Constructors resolve their own dependencies from the container, and dependency cycles are reported as errors.
+//
+// A Container is meant to be wired from a single goroutine, typically in main, and is not safe for concurrent use.
+// The zero value is ready to use.
+//
+// Example:
+//
+//	var c pocket.Container
+//	pocket.Provide(&c, "db", func() (*sql.DB, error) { return sql.Open("pgx", dsn) })
+//	pocket.Provide(&c, "users", func() (*UserRepo, error) {
+//		db, err := pocket.Resolve[*sql.DB](&c, "db")
+//		if err != nil {
+//			return nil, err
+//		}
+//		return NewUserRepo(db), nil
+//	})
+//	users, err := pocket.Resolve[*UserRepo](&c, "users")
+type Container struct {
+	providers map[string]*provider
+	resolving []string // names being constructed, outermost first
+}
+
+type provider struct {
+	typ   reflect.Type
+	ctor  func() (any, error)
+	value any
+	built bool
+}
+
+// Provide registers the constructor for name. Providing a name again replaces it and discards its value,
+// e.g. to swap in a fake in tests.
+func Provide[T any](c *Container, name string, ctor func() (T, error)) {
+	if c.providers == nil {
+		c.providers = make(map[string]*provider)
+	}
+	c.providers[name] = &provider{
+		typ:  reflect.TypeFor[T](),
+		ctor: func() (any, error) { return ctor() },
+	}
+}
+
+// Resolve returns the value for name, running its constructor the first time.
+// Returns an error if nothing was provided for name, if it was provided with a type other than T,
+// if its constructor fails (in which case the next Resolve tries again), or if it depends on itself.
+func Resolve[T any](c *Container, name string) (T, error) {
+	var zero T
+	p, ok := c.providers[name]
+	if !ok {
+		return zero, fmt.Errorf("nothing provided for %q", name)
+	}
+	if want := reflect.TypeFor[T](); p.typ != want {
+		return zero, fmt.Errorf("%q is provided as %s, not %s", name, p.typ, want)
+	}
+
+	if !p.built {
+		if i := slices.Index(c.resolving, name); i >= 0 {
+			cycle := append(c.resolving[i:len(c.resolving):len(c.resolving)], name)
+			return zero, fmt.Errorf("dependency cycle: %s", strings.Join(cycle, " -> "))
+		}
+
+		c.resolving = append(c.resolving, name)
+		v, err := p.ctor()
+		c.resolving = c.resolving[:len(c.resolving)-1]
+		if err != nil {
+			return zero, fmt.Errorf("cannot construct %q: %w", name, err)
+		}
+		p.value, p.built = v, true
+	}
+	v, _ := p.value.(T) // a nil interface value does not assert
+	return v, nil
+}
+
+// MustResolve works like Resolve but panics on error. Meant for wiring at startup.
+func MustResolve[T any](c *Container, name string) T {
+	v, err := Resolve[T](c, name)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
@@ -0,0 +1,119 @@
+package pocket
+
+import (
+	"errors"
+	"io"
+	"testing"
+)
+
+type testDB struct{ dsn string }
+
+type testRepo struct{ db *testDB }
+
+func TestContainer(t *testing.T) {
+	t.Run("resolves lazily and once", func(t *testing.T) {
+		t.Parallel()
+		var c Container
+		calls := 0
+		Provide(&c, "db", func() (*testDB, error) {
+			calls++
+			return &testDB{dsn: "postgres://"}, nil
+		})
+		Provide(&c, "repo", func() (*testRepo, error) {
+			db, err := Resolve[*testDB](&c, "db")
+			if err != nil {
+				return nil, err
+			}
+			return &testRepo{db: db}, nil
+		})
+		AssertEqual(t, calls, 0)
+
+		repo, err := Resolve[*testRepo](&c, "repo")
+		AssertNil(t, err)
+		db, err := Resolve[*testDB](&c, "db")
+		AssertNil(t, err)
+		AssertTrue(t, repo.db == db)
+		AssertEqual(t, calls, 1)
+	})
+
+	t.Run("missing provider", func(t *testing.T) {
+		t.Parallel()
+		var c Container
+		_, err := Resolve[int](&c, "port")
+		AssertNotNil(t, err)
+		AssertContains(t, err.Error(), `nothing provided for "port"`)
+	})
+
+	t.Run("wrong type", func(t *testing.T) {
+		t.Parallel()
+		var c Container
+		Provide(&c, "port", func() (int, error) { return 8080, nil })
+		_, err := Resolve[string](&c, "port")
+		AssertNotNil(t, err)
+		AssertContains(t, err.Error(), `"port" is provided as int, not string`)
+	})
+
+	t.Run("constructor errors are not cached", func(t *testing.T) {
+		t.Parallel()
+		var c Container
+		errDown := errors.New("db down")
+		calls := 0
+		Provide(&c, "db", func() (*testDB, error) {
+			calls++
+			if calls == 1 {
+				return nil, errDown
+			}
+			return &testDB{}, nil
+		})
+
+		_, err := Resolve[*testDB](&c, "db")
+		AssertErrorIs(t, err, errDown)
+		AssertContains(t, err.Error(), `cannot construct "db"`)
+
+		db, err := Resolve[*testDB](&c, "db")
+		AssertNil(t, err)
+		AssertNotNil(t, db)
+	})
+
+	t.Run("detects cycles", func(t *testing.T) {
+		t.Parallel()
+		var c Container
+		Provide(&c, "a", func() (int, error) { return Resolve[int](&c, "b") })
+		Provide(&c, "b", func() (int, error) { return Resolve[int](&c, "c") })
+		Provide(&c, "c", func() (int, error) { return Resolve[int](&c, "b") })
+
+		_, err := Resolve[int](&c, "a")
+		AssertNotNil(t, err)
+		AssertContains(t, err.Error(), "dependency cycle: b -> c -> b")
+
+		// The failed resolution does not leave names marked as in progress.
+		Provide(&c, "c", func() (int, error) { return 3, nil })
+		v, err := Resolve[int](&c, "a")
+		AssertNil(t, err)
+		AssertEqual(t, v, 3)
+	})
+
+	t.Run("replacing a provider", func(t *testing.T) {
+		t.Parallel()
+		var c Container
+		Provide(&c, "db", func() (*testDB, error) { return &testDB{dsn: "real"}, nil })
+		MustResolve[*testDB](&c, "db")
+		Provide(&c, "db", func() (*testDB, error) { return &testDB{dsn: "fake"}, nil })
+		AssertEqual(t, MustResolve[*testDB](&c, "db").dsn, "fake")
+	})
+
+	t.Run("interface types", func(t *testing.T) {
+		t.Parallel()
+		var c Container
+		Provide(&c, "closer", func() (io.Closer, error) { return nil, nil })
+		v, err := Resolve[io.Closer](&c, "closer")
+		AssertNil(t, err)
+		AssertNil(t, v)
+	})
+
+	t.Run("must resolve panics", func(t *testing.T) {
+		t.Parallel()
+		var c Container
+		AssertPanics(t, func() { MustResolve[int](&c, "missing") })
+	})
+}
@@ -0,0 +1,204 @@
+package pocket
+
+import "sync"
+
+// Deque is a double-ended queue backed by a ring buffer.
+// The zero value is an empty, unbounded deque that is not safe for concurrent use;
+// use NewDeque for a capacity limit and NewSyncDeque for a goroutine-safe one.
+type Deque[T any] struct {
+	mu       *sync.Mutex
+	buf      []T
+	head     int
+	n        int
+	capacity int // 0 means unbounded
+}
+
+// NewDeque returns an empty Deque holding at most capacity elements. A capacity of 0 means unbounded.
+func NewDeque[T any](capacity int) *Deque[T] {
+	return &Deque[T]{capacity: max(capacity, 0)}
+}
+
+// NewSyncDeque works like NewDeque, returning a Deque that is safe for concurrent use.
+func NewSyncDeque[T any](capacity int) *Deque[T] {
+	return &Deque[T]{mu: &sync.Mutex{}, capacity: max(capacity, 0)}
+}
+
+func (d *Deque[T]) lock() func() {
+	if d.mu == nil {
+		return func() {}
+	}
+	d.mu.Lock()
+	return d.mu.Unlock
+}
+
+// PushBack adds v at the back. It returns false, leaving the deque untouched, if the deque is full.
+func (d *Deque[T]) PushBack(v T) bool {
+	defer d.lock()()
+	if !d.reserve() {
+		return false
+	}
+	d.buf[(d.head+d.n)%len(d.buf)] = v
+	d.n++
+	return true
+}
+
+// PushFront adds v at the front. It returns false, leaving the deque untouched, if the deque is full.
+func (d *Deque[T]) PushFront(v T) bool {
+	defer d.lock()()
+	if !d.reserve() {
+		return false
+	}
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = v
+	d.n++
+	return true
+}
+
+// PopFront removes and returns the element at the front. The boolean is false if the deque is empty.
+func (d *Deque[T]) PopFront() (T, bool) {
+	defer d.lock()()
+	var zero T
+	if d.n == 0 {
+		return zero, false
+	}
+	v := d.buf[d.head]
+	d.buf[d.head] = zero
+	d.head = (d.head + 1) % len(d.buf)
+	d.n--
+	return v, true
+}
+
+// PopBack removes and returns the element at the back. The boolean is false if the deque is empty.
+func (d *Deque[T]) PopBack() (T, bool) {
+	defer d.lock()()
+	var zero T
+	if d.n == 0 {
+		return zero, false
+	}
+	i := (d.head + d.n - 1) % len(d.buf)
+	v := d.buf[i]
+	d.buf[i] = zero
+	d.n--
+	return v, true
+}
+
+// PeekFront returns the element at the front without removing it. The boolean is false if the deque is empty.
+func (d *Deque[T]) PeekFront() (T, bool) {
+	defer d.lock()()
+	if d.n == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[d.head], true
+}
+
+// PeekBack returns the element at the back without removing it. The boolean is false if the deque is empty.
+func (d *Deque[T]) PeekBack() (T, bool) {
+	defer d.lock()()
+	if d.n == 0 {
+		var zero T
+		return zero, false
+	}
+	return d.buf[(d.head+d.n-1)%len(d.buf)], true
+}
+
+// Len returns the number of elements.
+func (d *Deque[T]) Len() int {
+	defer d.lock()()
+	return d.n
+}
+
+// reserve makes room for one more element, reporting false if the capacity limit is reached.
+func (d *Deque[T]) reserve() bool {
+	if d.capacity > 0 && d.n >= d.capacity {
+		return false
+	}
+	if d.n < len(d.buf) {
+		return true
+	}
+
+	size := max(2*len(d.buf), 8)
+	if d.capacity > 0 {
+		size = min(size, d.capacity)
+	}
+	buf := make([]T, size)
+	for i := range d.n {
+		buf[i] = d.buf[(d.head+i)%len(d.buf)]
+	}
+	d.buf, d.head = buf, 0
+	return true
+}
+
+// Stack is a last-in, first-out container.
+// The zero value is an empty, unbounded stack that is not safe for concurrent use;
+// use NewStack for a capacity limit and NewSyncStack for a goroutine-safe one.
+type Stack[T any] struct {
+	d Deque[T]
+}
+
+// NewStack returns an empty Stack holding at most capacity elements. A capacity of 0 means unbounded.
+func NewStack[T any](capacity int) *Stack[T] {
+	return &Stack[T]{d: *NewDeque[T](capacity)}
+}
+
+// NewSyncStack works like NewStack, returning a Stack that is safe for concurrent use.
+func NewSyncStack[T any](capacity int) *Stack[T] {
+	return &Stack[T]{d: *NewSyncDeque[T](capacity)}
+}
+
+// Push adds v on top. It returns false, leaving the stack untouched, if the stack is full.
+func (s *Stack[T]) Push(v T) bool {
+	return s.d.PushBack(v)
+}
+
+// Pop removes and returns the element on top. The boolean is false if the stack is empty.
+func (s *Stack[T]) Pop() (T, bool) {
+	return s.d.PopBack()
+}
+
+// Peek returns the element on top without removing it. The boolean is false if the stack is empty.
+func (s *Stack[T]) Peek() (T, bool) {
+	return s.d.PeekBack()
+}
+
+// Len returns the number of elements.
+func (s *Stack[T]) Len() int {
+	return s.d.Len()
+}
+
+// Queue is a first-in, first-out container.
+// The zero value is an empty, unbounded queue that is not safe for concurrent use;
+// use NewQueue for a capacity limit and NewSyncQueue for a goroutine-safe one.
+type Queue[T any] struct {
+	d Deque[T]
+}
+
+// NewQueue returns an empty Queue holding at most capacity elements. A capacity of 0 means unbounded.
+func NewQueue[T any](capacity int) *Queue[T] {
+	return &Queue[T]{d: *NewDeque[T](capacity)}
+}
+
+// NewSyncQueue works like NewQueue, returning a Queue that is safe for concurrent use.
+func NewSyncQueue[T any](capacity int) *Queue[T] {
+	return &Queue[T]{d: *NewSyncDeque[T](capacity)}
+}
+
+// Push adds v at the back. It returns false, leaving the queue untouched, if the queue is full.
+func (q *Queue[T]) Push(v T) bool {
+	return q.d.PushBack(v)
+}
+
+// Pop removes and returns the element at the front. The boolean is false if the queue is empty.
+func (q *Queue[T]) Pop() (T, bool) {
+	return q.d.PopFront()
+}
+
+// Peek returns the element at the front without removing it. The boolean is false if the queue is empty.
+func (q *Queue[T]) Peek() (T, bool) {
+	return q.d.PeekFront()
+}
+
+// Len returns the number of elements.
+func (q *Queue[T]) Len() int {
+	return q.d.Len()
+}
@@ -0,0 +1,168 @@
+package pocket
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestStack(t *testing.T) {
+	var s Stack[int]
+
+	_, ok := s.Pop()
+	AssertFalse(t, ok)
+	_, ok = s.Peek()
+	AssertFalse(t, ok)
+
+	for i := range 20 {
+		AssertTrue(t, s.Push(i))
+	}
+	AssertEqual(t, s.Len(), 20)
+
+	top, ok := s.Peek()
+	AssertTrue(t, ok)
+	AssertEqual(t, top, 19)
+
+	for i := 19; i >= 0; i-- {
+		v, ok := s.Pop()
+		AssertTrue(t, ok)
+		AssertEqual(t, v, i)
+	}
+	AssertEqual(t, s.Len(), 0)
+}
+
+func TestQueue(t *testing.T) {
+	var q Queue[string]
+
+	_, ok := q.Pop()
+	AssertFalse(t, ok)
+
+	q.Push("a")
+	q.Push("b")
+	front, ok := q.Peek()
+	AssertTrue(t, ok)
+	AssertEqual(t, front, "a")
+
+	v, _ := q.Pop()
+	AssertEqual(t, v, "a")
+
+	// Wrap around the ring buffer and force it to grow while wrapped.
+	for i := range 20 {
+		q.Push(string(rune('c' + i)))
+	}
+	var got []string
+	for q.Len() > 0 {
+		v, _ := q.Pop()
+		got = append(got, v)
+	}
+	AssertEqual(t, len(got), 21)
+	AssertEqual(t, got[0], "b")
+	AssertEqual(t, got[1], "c")
+	AssertEqual(t, got[20], string(rune('c'+19)))
+}
+
+func TestDeque(t *testing.T) {
+	var d Deque[int]
+
+	d.PushBack(2)
+	d.PushFront(1)
+	d.PushBack(3)
+	d.PushFront(0)
+	AssertEqual(t, d.Len(), 4)
+
+	front, _ := d.PeekFront()
+	back, _ := d.PeekBack()
+	AssertEqual(t, front, 0)
+	AssertEqual(t, back, 3)
+
+	v, _ := d.PopFront()
+	AssertEqual(t, v, 0)
+	v, _ = d.PopBack()
+	AssertEqual(t, v, 3)
+	v, _ = d.PopBack()
+	AssertEqual(t, v, 2)
+	v, _ = d.PopFront()
+	AssertEqual(t, v, 1)
+
+	_, ok := d.PopFront()
+	AssertFalse(t, ok)
+	_, ok = d.PopBack()
+	AssertFalse(t, ok)
+	_, ok = d.PeekFront()
+	AssertFalse(t, ok)
+	_, ok = d.PeekBack()
+	AssertFalse(t, ok)
+
+	t.Run("front pushes grow correctly", func(t *testing.T) {
+		var d Deque[int]
+		for i := range 30 {
+			d.PushFront(i)
+		}
+		for i := 29; i >= 0; i-- {
+			v, _ := d.PopFront()
+			AssertEqual(t, v, i)
+		}
+	})
+}
+
+func TestContainerCapacity(t *testing.T) {
+	s := NewStack[int](2)
+	AssertTrue(t, s.Push(1))
+	AssertTrue(t, s.Push(2))
+	AssertFalse(t, s.Push(3))
+	v, _ := s.Pop()
+	AssertEqual(t, v, 2)
+	AssertTrue(t, s.Push(3))
+
+	q := NewQueue[int](1)
+	AssertTrue(t, q.Push(1))
+	AssertFalse(t, q.Push(2))
+
+	d := NewDeque[int](3)
+	AssertTrue(t, d.PushBack(1))
+	AssertTrue(t, d.PushFront(0))
+	AssertTrue(t, d.PushBack(2))
+	AssertFalse(t, d.PushFront(-1))
+	AssertFalse(t, d.PushBack(3))
+	AssertEqual(t, d.Len(), 3)
+}
+
+func TestSyncContainers(t *testing.T) {
+	s := NewSyncStack[int](0)
+	q := NewSyncQueue[int](0)
+	d := NewSyncDeque[int](100)
+
+	var wg sync.WaitGroup
+	for i := range 10 {
+		wg.Go(func() {
+			for j := range 100 {
+				s.Push(j)
+				q.Push(j)
+				if i%2 == 0 {
+					d.PushFront(j)
+				} else {
+					d.PushBack(j)
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	AssertEqual(t, s.Len(), 1000)
+	AssertEqual(t, q.Len(), 1000)
+	AssertEqual(t, d.Len(), 100)
+
+	for range 10 {
+		wg.Go(func() {
+			for range 100 {
+				s.Pop()
+				q.Pop()
+				d.PopBack()
+			}
+		})
+	}
+	wg.Wait()
+
+	AssertEqual(t, s.Len(), 0)
+	AssertEqual(t, q.Len(), 0)
+	AssertEqual(t, d.Len(), 0)
+}
@@ -0,0 +1,83 @@
+package pocket
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// SleepCtx pauses for d or until ctx is done, whichever happens first.
+// It returns nil after a full sleep, and the context's cause otherwise.
+func SleepCtx(ctx context.Context, d time.Duration) error {
+	return SleepCtxWithClock(ctx, RealClock{}, d)
+}
+
+// SleepCtxWithClock is like SleepCtx but measures time with the given Clock.
+func SleepCtxWithClock(ctx context.Context, clock Clock, d time.Duration) error {
+	select {
+	case <-clock.After(d):
+		return nil
+	case <-ctx.Done():
+		return context.Cause(ctx)
+	}
+}
+
+// withClockTimeout is like context.WithTimeout, but measures the timeout with the given Clock.
+// With a clock other than RealClock, the context is cancelled with context.DeadlineExceeded as its cause,
+// so ctx.Err() reports context.Canceled while context.Cause(ctx) reports the deadline.
+func withClockTimeout(ctx context.Context, clock Clock, d time.Duration) (context.Context, context.CancelFunc) {
+	if _, ok := clock.(RealClock); ok {
+		return context.WithTimeout(ctx, d)
+	}
+
+	ctx, cancel := context.WithCancelCause(ctx)
+	deadline := clock.After(d)
+	go func() {
+		select {
+		case <-deadline:
+			cancel(context.DeadlineExceeded)
+		case <-ctx.Done():
+		}
+	}()
+	return ctx, func() { cancel(context.Canceled) }
+}
+
+// Race runs every function concurrently and returns the result of the first one to succeed,
+// cancelling the context passed to the others. If all of them fail, it returns their errors joined.
+// If ctx is done first, it returns the context's cause.
+// Useful for hedged requests, e.g. querying several replicas and keeping the fastest answer.
+func Race[T any](ctx context.Context, fns ...func(ctx context.Context) (T, error)) (T, error) {
+	var zero T
+	if len(fns) == 0 {
+		return zero, errors.New("race needs at least one function")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	type result struct {
+		value T
+		err   error
+	}
+	results := make(chan result, len(fns))
+	for _, fn := range fns {
+		go func() {
+			v, err := fn(ctx)
+			results <- result{v, err}
+		}()
+	}
+
+	errs := make([]error, 0, len(fns))
+	for range fns {
+		select {
+		case r := <-results:
+			if r.err == nil {
+				return r.value, nil
+			}
+			errs = append(errs, r.err)
+		case <-ctx.Done():
+			return zero, context.Cause(ctx)
+		}
+	}
+	return zero, errors.Join(errs...)
+}
@@ -0,0 +1,125 @@
+package pocket
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSleepCtx(t *testing.T) {
+	t.Run("sleeps the full duration", func(t *testing.T) {
+		start := time.Now()
+		AssertNil(t, SleepCtx(context.Background(), 5*time.Millisecond))
+		AssertGreaterOrEqual(t, time.Since(start), 5*time.Millisecond)
+	})
+
+	t.Run("returns early when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		start := time.Now()
+		err := SleepCtx(ctx, time.Hour)
+		AssertErrorIs(t, err, context.Canceled)
+		AssertLess(t, time.Since(start), time.Second)
+	})
+
+	t.Run("returns the cause", func(t *testing.T) {
+		errShutdown := errors.New("shutting down")
+		ctx, cancel := context.WithCancelCause(context.Background())
+		cancel(errShutdown)
+
+		AssertErrorIs(t, SleepCtx(ctx, time.Hour), errShutdown)
+	})
+
+	t.Run("with a fake clock", func(t *testing.T) {
+		clock := NewFakeClock(epoch)
+		done := make(chan error, 1)
+		go func() { done <- SleepCtxWithClock(context.Background(), clock, time.Hour) }()
+
+		clock.BlockUntil(1)
+		clock.Advance(59 * time.Minute)
+		select {
+		case <-done:
+			t.Fatal("woke up before the fake time reached the deadline")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		clock.Advance(time.Minute)
+		AssertNil(t, <-done)
+	})
+}
+
+func TestRace(t *testing.T) {
+	waitThen := func(d time.Duration, v string, err error) func(context.Context) (string, error) {
+		return func(ctx context.Context) (string, error) {
+			if err := SleepCtx(ctx, d); err != nil {
+				return "", err
+			}
+			return v, err
+		}
+	}
+
+	t.Run("returns the first success", func(t *testing.T) {
+		v, err := Race(context.Background(),
+			waitThen(time.Hour, "slow", nil),
+			waitThen(time.Millisecond, "fast", nil),
+		)
+		AssertNil(t, err)
+		AssertEqual(t, v, "fast")
+	})
+
+	t.Run("skips failures", func(t *testing.T) {
+		v, err := Race(context.Background(),
+			waitThen(0, "", errors.New("replica down")),
+			waitThen(5*time.Millisecond, "replica 2", nil),
+		)
+		AssertNil(t, err)
+		AssertEqual(t, v, "replica 2")
+	})
+
+	t.Run("cancels the losers", func(t *testing.T) {
+		cancelled := make(chan struct{})
+		_, err := Race(context.Background(),
+			func(ctx context.Context) (int, error) {
+				<-ctx.Done()
+				close(cancelled)
+				return 0, ctx.Err()
+			},
+			func(context.Context) (int, error) { return 1, nil },
+		)
+		AssertNil(t, err)
+
+		select {
+		case <-cancelled:
+		case <-time.After(time.Second):
+			t.Error("losing function was not cancelled")
+		}
+	})
+
+	t.Run("joins errors when all fail", func(t *testing.T) {
+		errA, errB := errors.New("a"), errors.New("b")
+		_, err := Race(context.Background(),
+			waitThen(0, "", errA),
+			waitThen(time.Millisecond, "", errB),
+		)
+		AssertErrorIs(t, err, errA)
+		AssertErrorIs(t, err, errB)
+	})
+
+	t.Run("stops when the context is done", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Millisecond)
+		defer cancel()
+
+		_, err := Race(ctx, func(context.Context) (string, error) {
+			time.Sleep(50 * time.Millisecond)
+			return "too late", nil
+		})
+		AssertErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("needs at least one function", func(t *testing.T) {
+		_, err := Race[int](context.Background())
+		AssertNotNil(t, err)
+	})
+}
@@ -0,0 +1,164 @@
+package pocket
+
+import (
+	"encoding"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// csvColumn maps a CSV column to a struct field.
+type csvColumn struct {
+	name  string
+	field int
+}
+
+// csvColumns returns the columns of struct type t, from the `csv` tag of each exported field.
+// Fields without a tag use their name in snake_case, and fields tagged `csv:"-"` are skipped.
+func csvColumns(t reflect.Type) ([]csvColumn, error) {
+	if t.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("CSV rows must be structs, got %s", t)
+	}
+
+	var columns []csvColumn
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := f.Tag.Get("csv")
+		if name == "-" {
+			continue
+		}
+		if name == "" {
+			name = ToSnakeCase(f.Name)
+		}
+		columns = append(columns, csvColumn{name: name, field: i})
+	}
+	return columns, nil
+}
+
+// ReadCSV reads CSV rows into structs, matching the header row against the `csv` tags of T's fields
+// (fields without a tag use their name in snake_case, and fields tagged `csv:"-"` are skipped).
+// Values are parsed like LoadConfig parses them: strings, ints, bools, durations, TextUnmarshalers such as Money,
+// and types added with RegisterConfigParser. Empty cells leave the field at its zero value and extra columns are ignored.
+// Returns an error if a field's column is missing or a value cannot be parsed, reporting its line.
+//
+// Example:
+//
+//	type Product struct {
+//		SKU   string       `csv:"sku"`
+//		Price pocket.Money `csv:"price"`
+//		Stock int          `csv:"stock"`
+//	}
+//	products, err := pocket.ReadCSV[Product](file)
+func ReadCSV[T any](r io.Reader) ([]T, error) {
+	columns, err := csvColumns(reflect.TypeFor[T]())
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(r)
+	header, err := reader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, errors.New("missing CSV header")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	indexes := make(map[string]int, len(header))
+	for i, name := range header {
+		indexes[strings.TrimSpace(name)] = i
+	}
+	positions := make([]int, len(columns))
+	for i, col := range columns {
+		pos, ok := indexes[col.name]
+		if !ok {
+			return nil, fmt.Errorf("missing CSV column %q", col.name)
+		}
+		positions[i] = pos
+	}
+
+	var rows []T
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		var row T
+		v := reflect.ValueOf(&row).Elem()
+		for i, col := range columns {
+			raw := record[positions[i]]
+			if raw == "" {
+				continue
+			}
+			value, err := cast(v.Field(col.field).Type(), raw)
+			if err != nil {
+				return nil, fmt.Errorf("line %d, column %q: %w", line, col.name, err)
+			}
+			v.Field(col.field).Set(value)
+		}
+		rows = append(rows, row)
+	}
+}
+
+// WriteCSV writes a header row and then one row per struct, with the columns ReadCSV expects,
+// so the output can be read back. TextMarshalers such as Money are written with MarshalText,
+// and other values with their default fmt formatting. Pointers are written as the value they point to,
+// and nil pointers as empty cells.
+func WriteCSV[T any](w io.Writer, rows []T) error {
+	columns, err := csvColumns(reflect.TypeFor[T]())
+	if err != nil {
+		return err
+	}
+
+	writer := csv.NewWriter(w)
+	record := make([]string, len(columns))
+	for i, col := range columns {
+		record[i] = col.name
+	}
+	if err := writer.Write(record); err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		v := reflect.ValueOf(row)
+		for i, col := range columns {
+			s, err := formatCSVValue(v.Field(col.field))
+			if err != nil {
+				return fmt.Errorf("column %q: %w", col.name, err)
+			}
+			record[i] = s
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func formatCSVValue(v reflect.Value) (string, error) {
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return "", nil
+	}
+	if m, ok := v.Interface().(encoding.TextMarshaler); ok {
+		text, err := m.MarshalText()
+		return string(text), err
+	}
+	// Write what a pointer points to, not its address, unless it formats itself like *time.Location.
+	if _, ok := v.Interface().(fmt.Stringer); !ok && v.Kind() == reflect.Pointer {
+		return formatCSVValue(v.Elem())
+	}
+	return fmt.Sprint(v.Interface()), nil
+}
@@ -0,0 +1,118 @@
+package pocket
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+type csvProduct struct {
+	SKU      string        `csv:"sku"`
+	Price    Money         `csv:"price"`
+	Stock    int           `csv:"stock"`
+	Active   bool          `csv:"active"`
+	LeadTime time.Duration `csv:"lead_time"`
+	Internal string        `csv:"-"`
+	Notes    string
+	secret   string
+}
+
+func TestReadCSV(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reads rows by header", func(t *testing.T) {
+		t.Parallel()
+		input := "stock,sku,price,active,lead_time,notes,extra\n" +
+			"3,A-1,9.99 USD,true,48h,\"fragile, handle with care\",x\n" +
+			",B-2,0.50 USD,false,,,\n"
+
+		got, err := ReadCSV[csvProduct](strings.NewReader(input))
+		AssertNil(t, err)
+		AssertEqual(t, got, []csvProduct{
+			{SKU: "A-1", Price: NewUSD(999), Stock: 3, Active: true, LeadTime: 48 * time.Hour, Notes: "fragile, handle with care"},
+			{SKU: "B-2", Price: NewUSD(50)},
+		})
+	})
+
+	t.Run("only header", func(t *testing.T) {
+		t.Parallel()
+		got, err := ReadCSV[csvProduct](strings.NewReader("sku,price,stock,active,lead_time,notes\n"))
+		AssertNil(t, err)
+		AssertEmpty(t, got)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+		tests := []struct {
+			name  string
+			input string
+			want  string
+		}{
+			{"empty", "", "missing CSV header"},
+			{"missing column", "sku,price\n", `missing CSV column "stock"`},
+			{"invalid value", "sku,price,stock,active,lead_time,notes\nA,1.00 USD,lots,true,1h,\n", `line 2, column "stock"`},
+			{"ragged row", "sku,price,stock,active,lead_time,notes\nA\n", "wrong number of fields"},
+		}
+		for _, tt := range tests {
+			_, err := ReadCSV[csvProduct](strings.NewReader(tt.input))
+			AssertNotNil(t, err, tt.name)
+			if err != nil {
+				AssertContains(t, err.Error(), tt.want, tt.name)
+			}
+		}
+	})
+
+	t.Run("non-struct rows", func(t *testing.T) {
+		t.Parallel()
+		_, err := ReadCSV[int](strings.NewReader("a\n1\n"))
+		AssertNotNil(t, err)
+	})
+}
+
+func TestWriteCSV(t *testing.T) {
+	t.Parallel()
+	rows := []csvProduct{
+		{SKU: "A-1", Price: NewUSD(999), Stock: 3, Active: true, LeadTime: 48 * time.Hour, Internal: "hidden", Notes: "fragile, handle with care"},
+		{SKU: "B-2", Price: NewUSD(150)},
+	}
+
+	var buf bytes.Buffer
+	AssertNil(t, WriteCSV(&buf, rows))
+	AssertEqual(t, buf.String(), "sku,price,stock,active,lead_time,notes\n"+
+		"A-1,9.99 USD,3,true,48h0m0s,\"fragile, handle with care\"\n"+
+		"B-2,1.50 USD,0,false,0s,\n")
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+		got, err := ReadCSV[csvProduct](strings.NewReader(buf.String()))
+		AssertNil(t, err)
+		want := []csvProduct{rows[0], rows[1]}
+		want[0].Internal = "" // not written
+		AssertEqual(t, got, want)
+	})
+
+	t.Run("pointer fields", func(t *testing.T) {
+		t.Parallel()
+		type optional struct {
+			Name     string
+			Quantity *int
+			Price    *Money
+			Zone     *time.Location
+		}
+		quantity := 7
+		price := NewUSD(1250)
+		rows := []optional{
+			{Name: "set", Quantity: &quantity, Price: &price, Zone: time.UTC},
+			{Name: "unset"},
+		}
+
+		var buf bytes.Buffer
+		AssertNil(t, WriteCSV(&buf, rows))
+		AssertEqual(t, buf.String(), "name,quantity,price,zone\nset,7,12.50 USD,UTC\nunset,,,\n")
+
+		got, err := ReadCSV[optional](strings.NewReader(buf.String()))
+		AssertNil(t, err)
+		AssertEqual(t, got, rows)
+	})
+}
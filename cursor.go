@@ -0,0 +1,98 @@
+package pocket
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidCursor is returned when a cursor cannot be decoded or its signature does not match.
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// EncodeCursor returns an opaque, URL-safe pagination cursor holding v encoded as JSON.
+// Clients can still decode it, so use EncodeSignedCursor if they must not forge cursors.
+// It panics if v cannot be marshaled to JSON.
+func EncodeCursor(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("cannot encode cursor: %v", err))
+	}
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeCursor decodes a cursor produced by EncodeCursor into a T.
+// Returns ErrInvalidCursor if the cursor is malformed.
+func DecodeCursor[T any](cursor string) (T, error) {
+	var v T
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return v, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return v, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+	return v, nil
+}
+
+// EncodeSignedCursor works like EncodeCursor, appending an HMAC-SHA256 signature made with key
+// so that tampered cursors are rejected by DecodeSignedCursor.
+func EncodeSignedCursor(v any, key []byte) string {
+	payload := EncodeCursor(v)
+	return payload + "." + base64.RawURLEncoding.EncodeToString(cursorSignature(payload, key))
+}
+
+// DecodeSignedCursor verifies a cursor produced by EncodeSignedCursor with the same key and decodes it into a T.
+// Returns ErrInvalidCursor if the cursor is malformed or its signature does not match.
+func DecodeSignedCursor[T any](cursor string, key []byte) (T, error) {
+	var zero T
+	payload, sig, ok := strings.Cut(cursor, ".")
+	if !ok {
+		return zero, fmt.Errorf("%w: missing signature", ErrInvalidCursor)
+	}
+	got, err := base64.RawURLEncoding.DecodeString(sig)
+	if err != nil || !hmac.Equal(got, cursorSignature(payload, key)) {
+		return zero, fmt.Errorf("%w: bad signature", ErrInvalidCursor)
+	}
+	return DecodeCursor[T](payload)
+}
+
+func cursorSignature(payload string, key []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+// Page is a page of results for a cursor-paginated API response.
+type Page[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"next_cursor,omitempty"`
+	HasMore    bool   `json:"has_more"`
+}
+
+// NewPage builds a Page from items fetched with a limit of limit+1,
+// the extra item only telling whether there is a next page.
+// If there is, the extra item is dropped and NextCursor is set to cursor(last item on the page).
+// A limit below 1 is treated as 1, so a page that has more items always comes with a cursor.
+//
+// Example:
+//
+//	rows := db.ListOrders(after, limit+1)
+//	page := pocket.NewPage(rows, limit, func(o Order) string {
+//		return pocket.EncodeCursor(o.ID)
+//	})
+func NewPage[T any](items []T, limit int, cursor func(last T) string) Page[T] {
+	limit = max(limit, 1)
+	if items == nil {
+		items = []T{}
+	}
+	if len(items) <= limit {
+		return Page[T]{Items: items}
+	}
+
+	items = items[:limit:limit]
+	return Page[T]{Items: items, NextCursor: cursor(items[limit-1]), HasMore: true}
+}
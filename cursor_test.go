@@ -0,0 +1,112 @@
+package pocket
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+type orderCursor struct {
+	ID        int       `json:"id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func TestCursor(t *testing.T) {
+	t.Parallel()
+	want := orderCursor{ID: 42, CreatedAt: time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)}
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+		cursor := EncodeCursor(want)
+		AssertEqual(t, url.QueryEscape(cursor), cursor)
+
+		got, err := DecodeCursor[orderCursor](cursor)
+		AssertNil(t, err)
+		AssertEqual(t, got, want)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		for _, cursor := range []string{"not base64!", EncodeCursor("a string"), ""} {
+			_, err := DecodeCursor[orderCursor](cursor)
+			AssertErrorIs(t, err, ErrInvalidCursor)
+		}
+	})
+
+	t.Run("unencodable value panics", func(t *testing.T) {
+		t.Parallel()
+		AssertPanicsMatch(t, func() { EncodeCursor(make(chan int)) }, "cannot encode cursor")
+	})
+}
+
+func TestSignedCursor(t *testing.T) {
+	t.Parallel()
+	key := []byte("secret")
+	want := orderCursor{ID: 42}
+
+	t.Run("round trip", func(t *testing.T) {
+		t.Parallel()
+		cursor := EncodeSignedCursor(want, key)
+		AssertEqual(t, url.QueryEscape(cursor), cursor)
+
+		got, err := DecodeSignedCursor[orderCursor](cursor, key)
+		AssertNil(t, err)
+		AssertEqual(t, got, want)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		t.Parallel()
+		_, err := DecodeSignedCursor[orderCursor](EncodeSignedCursor(want, key), []byte("other"))
+		AssertErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("tampered payload", func(t *testing.T) {
+		t.Parallel()
+		_, sig, _ := strings.Cut(EncodeSignedCursor(want, key), ".")
+		forged := EncodeCursor(orderCursor{ID: 1}) + "." + sig
+		_, err := DecodeSignedCursor[orderCursor](forged, key)
+		AssertErrorIs(t, err, ErrInvalidCursor)
+	})
+
+	t.Run("unsigned cursor", func(t *testing.T) {
+		t.Parallel()
+		_, err := DecodeSignedCursor[orderCursor](EncodeCursor(want), key)
+		AssertErrorIs(t, err, ErrInvalidCursor)
+	})
+}
+
+func TestNewPage(t *testing.T) {
+	t.Parallel()
+	cursor := func(last int) string { return EncodeCursor(last) }
+
+	tests := []struct {
+		name  string
+		items []int
+		limit int
+		want  Page[int]
+	}{
+		{"more results", []int{1, 2, 3, 4}, 3, Page[int]{Items: []int{1, 2, 3}, NextCursor: EncodeCursor(3), HasMore: true}},
+		{"last page", []int{1, 2}, 3, Page[int]{Items: []int{1, 2}}},
+		{"exactly the limit", []int{1, 2, 3}, 3, Page[int]{Items: []int{1, 2, 3}}},
+		{"empty", nil, 3, Page[int]{Items: []int{}}},
+		{"zero limit is treated as one", []int{1, 2}, 0, Page[int]{Items: []int{1}, NextCursor: EncodeCursor(1), HasMore: true}},
+		{"negative limit is treated as one", []int{1, 2}, -5, Page[int]{Items: []int{1}, NextCursor: EncodeCursor(1), HasMore: true}},
+		{"single item with zero limit", []int{1}, 0, Page[int]{Items: []int{1}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, NewPage(tt.items, tt.limit, cursor), tt.want)
+		})
+	}
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+		data, err := json.Marshal(NewPage(nil, 3, cursor))
+		AssertNil(t, err)
+		AssertEqual(t, string(data), `{"items":[],"has_more":false}`)
+	})
+}
@@ -0,0 +1,80 @@
+package pocket
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Dedup deduplicates concurrent calls by key, singleflight-style:
+// while a call for a key is in flight, other callers asking for the same key wait for it
+// and share its result instead of starting their own. This prevents cache stampedes,
+// where many requests miss the cache at once and all hit the backend.
+// The zero value is ready to use. It must not be copied after first use.
+type Dedup[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*dedupCall[V]
+}
+
+type dedupCall[V any] struct {
+	done   chan struct{}
+	value  V
+	err    error
+	shared int // number of callers waiting besides the first one
+}
+
+// Do runs fn for the key, unless a call for the same key is already in flight,
+// in which case it waits for that call and returns its result.
+// The boolean reports whether the result was shared with another caller,
+// which is true for the caller that started the call as well as for those who joined it.
+//
+// fn runs with a context that keeps the values of the first caller's ctx but is never cancelled,
+// since other callers may still be waiting for it. Each caller stops waiting when its own ctx is done,
+// returning ctx.Err(), while the call carries on for the rest.
+// A panic in fn is turned into an error returned to every caller.
+func (d *Dedup[K, V]) Do(ctx context.Context, key K, fn func(ctx context.Context) (V, error)) (V, error, bool) {
+	d.mu.Lock()
+	if d.calls == nil {
+		d.calls = make(map[K]*dedupCall[V])
+	}
+	call, shared := d.calls[key]
+	if shared {
+		call.shared++
+	} else {
+		call = &dedupCall[V]{done: make(chan struct{})}
+		d.calls[key] = call
+		go d.run(context.WithoutCancel(ctx), key, call, fn)
+	}
+	d.mu.Unlock()
+
+	select {
+	case <-call.done:
+		// No caller can join once done is closed, so reading call.shared needs no lock.
+		return call.value, call.err, shared || call.shared > 0
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err(), shared
+	}
+}
+
+// InFlight returns the number of keys with a call currently running.
+func (d *Dedup[K, V]) InFlight() int {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return len(d.calls)
+}
+
+func (d *Dedup[K, V]) run(ctx context.Context, key K, call *dedupCall[V], fn func(ctx context.Context) (V, error)) {
+	defer func() {
+		if r := recover(); r != nil {
+			call.err = fmt.Errorf("deduplicated call panicked: %v", r)
+		}
+
+		d.mu.Lock()
+		delete(d.calls, key)
+		d.mu.Unlock()
+		close(call.done)
+	}()
+
+	call.value, call.err = fn(ctx)
+}
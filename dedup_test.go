@@ -0,0 +1,119 @@
+package pocket
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDedup(t *testing.T) {
+	t.Run("concurrent callers share one execution", func(t *testing.T) {
+		var d Dedup[string, int]
+		var executions atomic.Int32
+		release := make(chan struct{})
+		started := make(chan struct{})
+
+		fn := func(context.Context) (int, error) {
+			if executions.Add(1) == 1 {
+				close(started)
+			}
+			<-release
+			return 42, nil
+		}
+
+		var wg sync.WaitGroup
+		results := make([]int, 10)
+		sharedCount := atomic.Int32{}
+		var firstShared bool
+
+		wg.Go(func() {
+			results[0], _, firstShared = d.Do(context.Background(), "key", fn)
+		})
+		<-started
+		for i := 1; i < len(results); i++ {
+			wg.Go(func() {
+				v, err, shared := d.Do(context.Background(), "key", fn)
+				AssertNil(t, err)
+				if shared {
+					sharedCount.Add(1)
+				}
+				results[i] = v
+			})
+		}
+
+		RequireEventually(t, func() bool {
+			d.mu.Lock()
+			defer d.mu.Unlock()
+			return d.calls["key"].shared == 9
+		}, time.Second, time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		AssertEqual(t, executions.Load(), int32(1))
+		AssertEqual(t, sharedCount.Load(), int32(9))
+		AssertTrue(t, firstShared)
+		AssertEqual(t, results, Repeat(42, 10))
+		AssertEqual(t, d.InFlight(), 0)
+	})
+
+	t.Run("different keys run independently", func(t *testing.T) {
+		var d Dedup[int, int]
+		for i := range 3 {
+			v, err, shared := d.Do(context.Background(), i, func(context.Context) (int, error) { return i * 10, nil })
+			AssertNil(t, err)
+			AssertFalse(t, shared)
+			AssertEqual(t, v, i*10)
+		}
+	})
+
+	t.Run("runs again after the call completes", func(t *testing.T) {
+		var d Dedup[string, int]
+		calls := 0
+		fn := func(context.Context) (int, error) {
+			calls++
+			return calls, nil
+		}
+
+		first, _, _ := d.Do(context.Background(), "key", fn)
+		second, _, _ := d.Do(context.Background(), "key", fn)
+		AssertEqual(t, first, 1)
+		AssertEqual(t, second, 2)
+	})
+
+	t.Run("shares errors", func(t *testing.T) {
+		var d Dedup[string, int]
+		errBoom := errors.New("boom")
+		_, err, _ := d.Do(context.Background(), "key", func(context.Context) (int, error) { return 0, errBoom })
+		AssertErrorIs(t, err, errBoom)
+	})
+
+	t.Run("turns panics into errors", func(t *testing.T) {
+		var d Dedup[string, int]
+		_, err, _ := d.Do(context.Background(), "key", func(context.Context) (int, error) { panic("kaboom") })
+		AssertNotNil(t, err)
+		AssertContains(t, err.Error(), "kaboom")
+		AssertEqual(t, d.InFlight(), 0)
+	})
+
+	t.Run("caller stops waiting when its context is done", func(t *testing.T) {
+		var d Dedup[string, int]
+		release := make(chan struct{})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		var fnCtxErr error
+		_, err, _ := d.Do(ctx, "key", func(fnCtx context.Context) (int, error) {
+			<-release
+			fnCtxErr = fnCtx.Err()
+			return 1, nil
+		})
+		AssertErrorIs(t, err, context.Canceled)
+
+		close(release)
+		RequireEventually(t, func() bool { return d.InFlight() == 0 }, time.Second, time.Millisecond)
+		AssertNil(t, fnCtxErr)
+	})
+}
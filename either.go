@@ -0,0 +1,64 @@
+package pocket
+
+// Either holds a value of one of two types: a Left or a Right.
+// Useful for functions that legitimately return one of two payloads, e.g. a cached or a freshly computed result.
+// Unlike Result, neither side is an error.
+type Either[L any, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// Left returns an Either holding the left value v.
+func Left[L any, R any](v L) Either[L, R] {
+	return Either[L, R]{left: v}
+}
+
+// Right returns an Either holding the right value v.
+func Right[L any, R any](v R) Either[L, R] {
+	return Either[L, R]{right: v, isRight: true}
+}
+
+// IsLeft reports whether the Either holds a left value.
+func (e Either[L, R]) IsLeft() bool {
+	return !e.isRight
+}
+
+// IsRight reports whether the Either holds a right value.
+func (e Either[L, R]) IsRight() bool {
+	return e.isRight
+}
+
+// LeftValue returns the left value, and whether the Either holds one.
+func (e Either[L, R]) LeftValue() (L, bool) {
+	return e.left, !e.isRight
+}
+
+// RightValue returns the right value, and whether the Either holds one.
+func (e Either[L, R]) RightValue() (R, bool) {
+	return e.right, e.isRight
+}
+
+// Fold reduces the Either to a single value, calling onLeft or onRight depending on which side it holds.
+func Fold[L any, R any, T any](e Either[L, R], onLeft func(L) T, onRight func(R) T) T {
+	if e.isRight {
+		return onRight(e.right)
+	}
+	return onLeft(e.left)
+}
+
+// MapLeft applies f to the left value. An Either holding a right value is passed through unchanged.
+func MapLeft[L any, R any, M any](e Either[L, R], f func(L) M) Either[M, R] {
+	if e.isRight {
+		return Right[M](e.right)
+	}
+	return Left[M, R](f(e.left))
+}
+
+// MapRight applies f to the right value. An Either holding a left value is passed through unchanged.
+func MapRight[L any, R any, S any](e Either[L, R], f func(R) S) Either[L, S] {
+	if e.isRight {
+		return Right[L](f(e.right))
+	}
+	return Left[L, S](e.left)
+}
@@ -0,0 +1,55 @@
+package pocket
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestEither(t *testing.T) {
+	t.Run("left", func(t *testing.T) {
+		e := Left[string, int]("cached")
+		AssertTrue(t, e.IsLeft())
+		AssertFalse(t, e.IsRight())
+
+		l, ok := e.LeftValue()
+		AssertTrue(t, ok)
+		AssertEqual(t, l, "cached")
+
+		_, ok = e.RightValue()
+		AssertFalse(t, ok)
+	})
+
+	t.Run("right", func(t *testing.T) {
+		e := Right[string](42)
+		AssertFalse(t, e.IsLeft())
+		AssertTrue(t, e.IsRight())
+
+		r, ok := e.RightValue()
+		AssertTrue(t, ok)
+		AssertEqual(t, r, 42)
+
+		_, ok = e.LeftValue()
+		AssertFalse(t, ok)
+	})
+
+	t.Run("zero value is left", func(t *testing.T) {
+		var e Either[string, int]
+		AssertTrue(t, e.IsLeft())
+	})
+}
+
+func TestFold(t *testing.T) {
+	length := func(s string) int { return len(s) }
+	identity := func(i int) int { return i }
+
+	AssertEqual(t, Fold(Left[string, int]("abc"), length, identity), 3)
+	AssertEqual(t, Fold(Right[string](7), length, identity), 7)
+}
+
+func TestMapEither(t *testing.T) {
+	AssertEqual(t, MapLeft(Left[int, bool](3), strconv.Itoa), Left[string, bool]("3"))
+	AssertEqual(t, MapLeft(Right[int](true), strconv.Itoa), Right[string](true))
+
+	AssertEqual(t, MapRight(Right[bool](3), strconv.Itoa), Right[bool]("3"))
+	AssertEqual(t, MapRight(Left[bool, int](true), strconv.Itoa), Left[bool, string](true))
+}
@@ -0,0 +1,112 @@
+package pocket
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// base58Alphabet is the Bitcoin Base58 alphabet, which excludes 0, O, I, and l to avoid ambiguity.
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+// EncodeBase58 encodes b using the Bitcoin Base58 alphabet.
+// Leading zero bytes are preserved as leading '1' characters.
+func EncodeBase58(b []byte) string {
+	zeros := 0
+	for zeros < len(b) && b[zeros] == 0 {
+		zeros++
+	}
+
+	n := new(big.Int).SetBytes(b)
+	base := big.NewInt(58)
+	mod := new(big.Int)
+
+	var out []byte
+	for n.Sign() > 0 {
+		n.DivMod(n, base, mod)
+		out = append(out, base58Alphabet[mod.Int64()])
+	}
+	for range zeros {
+		out = append(out, base58Alphabet[0])
+	}
+
+	for i, j := 0, len(out)-1; i < j; i, j = i+1, j-1 {
+		out[i], out[j] = out[j], out[i]
+	}
+	return string(out)
+}
+
+// DecodeBase58 decodes a string produced by EncodeBase58.
+// Returns an error if s contains characters outside the Base58 alphabet.
+func DecodeBase58(s string) ([]byte, error) {
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+
+	n := new(big.Int)
+	base := big.NewInt(58)
+	for i := 0; i < len(s); i++ {
+		v := strings.IndexByte(base58Alphabet, s[i])
+		if v < 0 {
+			return nil, fmt.Errorf("invalid base58 character %q at position %d", s[i], i)
+		}
+		n.Mul(n, base)
+		n.Add(n, big.NewInt(int64(v)))
+	}
+
+	return append(make([]byte, zeros), n.Bytes()...), nil
+}
+
+// EncodeCrockford encodes b using Crockford's Base32 alphabet, without padding.
+func EncodeCrockford(b []byte) string {
+	var sb strings.Builder
+	sb.Grow((len(b)*8 + 4) / 5)
+
+	var buf uint16
+	bits := 0
+	for _, c := range b {
+		buf = buf<<8 | uint16(c)
+		bits += 8
+		for bits >= 5 {
+			bits -= 5
+			sb.WriteByte(crockfordAlphabet[(buf>>bits)&31])
+		}
+	}
+	if bits > 0 {
+		sb.WriteByte(crockfordAlphabet[(buf<<(5-bits))&31])
+	}
+
+	return sb.String()
+}
+
+// DecodeCrockford decodes a string produced by EncodeCrockford.
+// Decoding is case-insensitive, reads I and L as 1 and O as 0, and ignores hyphens,
+// so identifiers survive being read aloud or retyped by hand.
+func DecodeCrockford(s string) ([]byte, error) {
+	out := make([]byte, 0, len(s)*5/8)
+
+	var buf uint16
+	bits := 0
+	for i := 0; i < len(s); i++ {
+		if s[i] == '-' {
+			continue
+		}
+		v, ok := crockfordValue(s[i])
+		if !ok {
+			return nil, fmt.Errorf("invalid crockford base32 character %q at position %d", s[i], i)
+		}
+		buf = buf<<5 | uint16(v)
+		bits += 5
+		if bits >= 8 {
+			bits -= 8
+			out = append(out, byte(buf>>bits))
+		}
+	}
+
+	if bits >= 5 || buf&(1<<bits-1) != 0 {
+		return nil, fmt.Errorf("invalid crockford base32 length or trailing bits")
+	}
+
+	return out, nil
+}
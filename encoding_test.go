@@ -0,0 +1,91 @@
+package pocket
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestBase58(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []byte
+		encoded string
+	}{
+		{name: "empty", input: []byte{}, encoded: ""},
+		{name: "hello world", input: []byte("hello world"), encoded: "StV1DL6CwTryKyV"},
+		{name: "leading zeros", input: []byte{0, 0, 1}, encoded: "112"},
+		{name: "single zero", input: []byte{0}, encoded: "1"},
+		{name: "max byte", input: []byte{255}, encoded: "5Q"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, EncodeBase58(tt.input), tt.encoded)
+
+			decoded, err := DecodeBase58(tt.encoded)
+			AssertNil(t, err)
+			AssertTrue(t, bytes.Equal(decoded, tt.input))
+		})
+	}
+
+	t.Run("rejects invalid characters", func(t *testing.T) {
+		_, err := DecodeBase58("abc0")
+		AssertNotNil(t, err)
+		AssertContains(t, err.Error(), "invalid base58 character")
+	})
+}
+
+func TestCrockford(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   []byte
+		encoded string
+	}{
+		{name: "empty", input: []byte{}, encoded: ""},
+		{name: "single byte", input: []byte{0xff}, encoded: "ZW"},
+		{name: "five bytes", input: []byte("hello"), encoded: "D1JPRV3F"},
+		{name: "zeros", input: []byte{0, 0}, encoded: "0000"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, EncodeCrockford(tt.input), tt.encoded)
+
+			decoded, err := DecodeCrockford(tt.encoded)
+			AssertNil(t, err)
+			AssertTrue(t, bytes.Equal(decoded, tt.input))
+		})
+	}
+
+	t.Run("decodes leniently", func(t *testing.T) {
+		decoded, err := DecodeCrockford("d1jp-rv3f")
+		AssertNil(t, err)
+		AssertEqual(t, string(decoded), "hello")
+
+		decoded, err = DecodeCrockford("oO")
+		AssertNil(t, err)
+		AssertTrue(t, bytes.Equal(decoded, []byte{0}))
+	})
+
+	t.Run("round trips random bytes", func(t *testing.T) {
+		for n := range 20 {
+			input := []byte(GenerateHex(2 * (n + 1)))
+			decoded, err := DecodeCrockford(EncodeCrockford(input))
+			AssertNil(t, err)
+			AssertTrue(t, bytes.Equal(decoded, input))
+		}
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		_, err := DecodeCrockford("U0")
+		AssertNotNil(t, err)
+
+		_, err = DecodeCrockford("ZZ")
+		AssertNotNil(t, err)
+
+		_, err = DecodeCrockford("ZZZ")
+		AssertNotNil(t, err)
+	})
+}
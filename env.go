@@ -0,0 +1,52 @@
+package pocket
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+)
+
+// GetEnv returns the environment variable name converted to T, with the same parsing rules as LoadConfigFromEnv
+// (built-in types, TextUnmarshalers and parsers added with RegisterConfigParser).
+// It returns an error if the variable is not set or cannot be parsed.
+func GetEnv[T any](name string) (T, error) {
+	var zero T
+	raw, ok := os.LookupEnv(name)
+	if !ok {
+		return zero, fmt.Errorf("missing value for %v", name)
+	}
+
+	v, err := cast(reflect.TypeFor[T](), raw)
+	if err != nil {
+		return zero, fmt.Errorf("invalid value for %v: %w", name, err)
+	}
+	return v.Interface().(T), nil
+}
+
+// GetEnvOr works like GetEnv, returning fallback if the variable is not set, empty, or cannot be parsed.
+// A malformed value such as PORT=80a therefore goes unnoticed; use MustEnvOr to fail loudly on it instead.
+func GetEnvOr[T any](name string, fallback T) T {
+	v, err := GetEnv[T](name)
+	if err != nil {
+		return fallback
+	}
+	return v
+}
+
+// MustEnvOr works like GetEnvOr, returning fallback if the variable is not set or empty,
+// but panics if it is set and cannot be parsed. Meant for optional settings read at startup.
+func MustEnvOr[T any](name string, fallback T) T {
+	if os.Getenv(name) == "" {
+		return fallback
+	}
+	return MustEnv[T](name)
+}
+
+// MustEnv works like GetEnv but panics on error. Meant for required settings read at startup.
+func MustEnv[T any](name string) T {
+	v, err := GetEnv[T](name)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
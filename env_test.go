@@ -0,0 +1,71 @@
+package pocket
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGetEnv(t *testing.T) {
+	t.Setenv("POCKET_TEST_PORT", "8080")
+	t.Setenv("POCKET_TEST_TIMEOUT", "5s")
+	t.Setenv("POCKET_TEST_PRICE", "9.99 USD")
+	t.Setenv("POCKET_TEST_BAD_INT", "eighty")
+
+	t.Run("parses built-in types", func(t *testing.T) {
+		port, err := GetEnv[int]("POCKET_TEST_PORT")
+		AssertNil(t, err)
+		AssertEqual(t, port, 8080)
+
+		timeout, err := GetEnv[time.Duration]("POCKET_TEST_TIMEOUT")
+		AssertNil(t, err)
+		AssertEqual(t, timeout, 5*time.Second)
+	})
+
+	t.Run("parses text unmarshalers", func(t *testing.T) {
+		price, err := GetEnv[Money]("POCKET_TEST_PRICE")
+		AssertNil(t, err)
+		AssertMoneyEqual(t, price, NewUSD(999))
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		_, err := GetEnv[string]("POCKET_TEST_MISSING")
+		AssertNotNil(t, err)
+		AssertContains(t, err.Error(), "missing value for POCKET_TEST_MISSING")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := GetEnv[int]("POCKET_TEST_BAD_INT")
+		AssertNotNil(t, err)
+		AssertContains(t, err.Error(), "invalid value for POCKET_TEST_BAD_INT")
+	})
+}
+
+func TestGetEnvOr(t *testing.T) {
+	t.Setenv("POCKET_TEST_PORT", "8080")
+	t.Setenv("POCKET_TEST_BAD_INT", "eighty")
+
+	t.Setenv("POCKET_TEST_EMPTY", "")
+
+	AssertEqual(t, GetEnvOr("POCKET_TEST_PORT", 3000), 8080)
+	AssertEqual(t, GetEnvOr("POCKET_TEST_MISSING", 3000), 3000)
+	AssertEqual(t, GetEnvOr("POCKET_TEST_EMPTY", 3000), 3000)
+	AssertEqual(t, GetEnvOr("POCKET_TEST_BAD_INT", 3000), 3000)
+}
+
+func TestMustEnvOr(t *testing.T) {
+	t.Setenv("POCKET_TEST_PORT", "8080")
+	t.Setenv("POCKET_TEST_BAD_INT", "eighty")
+	t.Setenv("POCKET_TEST_EMPTY", "")
+
+	AssertEqual(t, MustEnvOr("POCKET_TEST_PORT", 3000), 8080)
+	AssertEqual(t, MustEnvOr("POCKET_TEST_MISSING", 3000), 3000)
+	AssertEqual(t, MustEnvOr("POCKET_TEST_EMPTY", 3000), 3000)
+	AssertPanicsMatch(t, func() { MustEnvOr("POCKET_TEST_BAD_INT", 3000) }, "invalid value for POCKET_TEST_BAD_INT")
+}
+
+func TestMustEnv(t *testing.T) {
+	t.Setenv("POCKET_TEST_DEBUG", "true")
+
+	AssertTrue(t, MustEnv[bool]("POCKET_TEST_DEBUG"))
+	AssertPanics(t, func() { MustEnv[bool]("POCKET_TEST_MISSING") })
+}
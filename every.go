@@ -0,0 +1,128 @@
+package pocket
+
+import (
+	"context"
+	"fmt"
+	mrand "math/rand/v2"
+	"time"
+)
+
+type everyConfig struct {
+	immediate  bool
+	jitter     float64
+	maxBackoff time.Duration
+	recover    bool
+	onError    func(err error)
+	clock      Clock
+}
+
+// EveryOption configures Every.
+type EveryOption func(*everyConfig)
+
+// EveryImmediately makes Every run fn as soon as it starts, instead of after the first interval.
+func EveryImmediately() EveryOption {
+	return func(c *everyConfig) {
+		c.immediate = true
+	}
+}
+
+// WithEveryJitter randomly lengthens each wait by up to this fraction (0 to 1) of the interval,
+// to keep many instances from running in lockstep.
+func WithEveryJitter(fraction float64) EveryOption {
+	return func(c *everyConfig) {
+		c.jitter = min(max(fraction, 0), 1)
+	}
+}
+
+// WithEveryBackoff doubles the wait after each consecutive failure, up to max, going back to the interval after a success.
+func WithEveryBackoff(max time.Duration) EveryOption {
+	return func(c *everyConfig) {
+		c.maxBackoff = max
+	}
+}
+
+// WithEveryRecover turns panics in fn into errors instead of crashing the program.
+func WithEveryRecover() EveryOption {
+	return func(c *everyConfig) {
+		c.recover = true
+	}
+}
+
+// WithEveryErrorHandler sets a function called with every error returned by fn. By default, errors are ignored.
+func WithEveryErrorHandler(f func(err error)) EveryOption {
+	return func(c *everyConfig) {
+		c.onError = f
+	}
+}
+
+// WithEveryClock sets the Clock used to wait between runs. Defaults to RealClock; use a FakeClock in tests.
+func WithEveryClock(clock Clock) EveryOption {
+	return func(c *everyConfig) {
+		c.clock = clock
+	}
+}
+
+// Every runs fn every interval until ctx is done, and returns the context's cause.
+// The interval is measured from the end of one run to the start of the next, so runs never overlap.
+// Errors don't stop the loop; see WithEveryErrorHandler and WithEveryBackoff.
+// Panics if interval is not positive.
+//
+// Example:
+//
+//	go pocket.Every(ctx, 30*time.Second, sendHeartbeat,
+//		pocket.EveryImmediately(),
+//		pocket.WithEveryBackoff(5*time.Minute),
+//		pocket.WithEveryErrorHandler(func(err error) { log.Printf("heartbeat: %v", err) }),
+//	)
+func Every(ctx context.Context, interval time.Duration, fn func(ctx context.Context) error, opts ...EveryOption) error {
+	if interval <= 0 {
+		panic(fmt.Sprintf("Every interval must be positive, got %s", interval))
+	}
+	cfg := everyConfig{clock: RealClock{}}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	delay := interval
+	wait := delay
+	if cfg.immediate {
+		wait = 0
+	}
+	for {
+		if wait > 0 {
+			select {
+			case <-cfg.clock.After(wait):
+			case <-ctx.Done():
+				return context.Cause(ctx)
+			}
+		} else if ctx.Err() != nil {
+			return context.Cause(ctx)
+		}
+
+		err := cfg.run(ctx, fn)
+		if err != nil && cfg.onError != nil {
+			cfg.onError(err)
+		}
+
+		if err != nil && cfg.maxBackoff > 0 {
+			delay = max(min(delay*2, cfg.maxBackoff), interval)
+		} else {
+			delay = interval
+		}
+		wait = delay
+		if cfg.jitter > 0 {
+			wait += time.Duration(mrand.Float64() * cfg.jitter * float64(interval))
+		}
+	}
+}
+
+func (c everyConfig) run(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	if c.recover {
+		defer func() {
+			if r := recover(); r != nil {
+				err = fmt.Errorf("panic: %v", r)
+			}
+		}()
+	}
+	return fn(ctx)
+}
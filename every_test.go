@@ -0,0 +1,164 @@
+package pocket
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// everyHarness runs Every in the background with a FakeClock and records when fn runs.
+type everyHarness struct {
+	clock  *FakeClock
+	cancel context.CancelFunc
+	runs   chan time.Time
+	done   chan error
+}
+
+func startEvery(t *testing.T, interval time.Duration, fn func() error, opts ...EveryOption) *everyHarness {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	h := &everyHarness{
+		clock:  NewFakeClock(epoch),
+		cancel: cancel,
+		runs:   make(chan time.Time, 100),
+		done:   make(chan error, 1),
+	}
+	opts = append(opts, WithEveryClock(h.clock))
+	go func() {
+		h.done <- Every(ctx, interval, func(context.Context) error {
+			h.runs <- h.clock.Now()
+			return fn()
+		}, opts...)
+	}()
+	t.Cleanup(cancel)
+	return h
+}
+
+// tick waits for Every to be waiting, then advances the clock by d.
+func (h *everyHarness) tick(d time.Duration) {
+	h.clock.BlockUntil(1)
+	h.clock.Advance(d)
+}
+
+func (h *everyHarness) nextRun(t *testing.T) time.Time {
+	t.Helper()
+	select {
+	case at := <-h.runs:
+		return at
+	case <-time.After(time.Second):
+		t.Fatal("fn did not run")
+		return time.Time{}
+	}
+}
+
+func TestEvery(t *testing.T) {
+	t.Run("runs every interval", func(t *testing.T) {
+		t.Parallel()
+		h := startEvery(t, time.Minute, func() error { return nil })
+
+		h.tick(time.Minute)
+		AssertEqual(t, h.nextRun(t), epoch.Add(time.Minute))
+		h.tick(time.Minute)
+		AssertEqual(t, h.nextRun(t), epoch.Add(2*time.Minute))
+	})
+
+	t.Run("immediately", func(t *testing.T) {
+		t.Parallel()
+		h := startEvery(t, time.Minute, func() error { return nil }, EveryImmediately())
+
+		AssertEqual(t, h.nextRun(t), epoch)
+		h.tick(time.Minute)
+		AssertEqual(t, h.nextRun(t), epoch.Add(time.Minute))
+	})
+
+	t.Run("returns the cause when ctx is done", func(t *testing.T) {
+		t.Parallel()
+		errStop := errors.New("stopping")
+		ctx, cancel := context.WithCancelCause(context.Background())
+		cancel(errStop)
+
+		err := Every(ctx, time.Minute, func(context.Context) error { return nil }, EveryImmediately())
+		AssertErrorIs(t, err, errStop)
+	})
+
+	t.Run("errors go to the handler and back off", func(t *testing.T) {
+		t.Parallel()
+		errFailed := errors.New("failed")
+		var mu sync.Mutex
+		var errs []error
+		fail := true
+		h := startEvery(t, time.Minute, func() error {
+			mu.Lock()
+			defer mu.Unlock()
+			if fail {
+				return errFailed
+			}
+			return nil
+		},
+			EveryImmediately(),
+			WithEveryBackoff(3*time.Minute),
+			WithEveryErrorHandler(func(err error) {
+				mu.Lock()
+				defer mu.Unlock()
+				errs = append(errs, err)
+			}),
+		)
+
+		AssertEqual(t, h.nextRun(t), epoch) // fails, next wait 2m
+		h.tick(2 * time.Minute)
+		AssertEqual(t, h.nextRun(t), epoch.Add(2*time.Minute)) // fails, next wait capped at 3m
+		h.tick(3 * time.Minute)
+		AssertEqual(t, h.nextRun(t), epoch.Add(5*time.Minute)) // fails
+
+		h.clock.BlockUntil(1)
+		mu.Lock()
+		AssertEqual(t, errs, []error{errFailed, errFailed, errFailed})
+		fail = false
+		mu.Unlock()
+
+		h.tick(3 * time.Minute)
+		AssertEqual(t, h.nextRun(t), epoch.Add(8*time.Minute)) // succeeds, back to the interval
+		h.tick(time.Minute)
+		AssertEqual(t, h.nextRun(t), epoch.Add(9*time.Minute))
+	})
+
+	t.Run("recovers panics", func(t *testing.T) {
+		t.Parallel()
+		errs := make(chan error, 1)
+		h := startEvery(t, time.Minute, func() error { panic("boom") },
+			EveryImmediately(),
+			WithEveryRecover(),
+			WithEveryErrorHandler(func(err error) { errs <- err }),
+		)
+
+		h.nextRun(t)
+		AssertContains(t, (<-errs).Error(), "panic: boom")
+		h.cancel()
+		AssertErrorIs(t, <-h.done, context.Canceled)
+	})
+
+	t.Run("jitter lengthens the wait", func(t *testing.T) {
+		t.Parallel()
+		h := startEvery(t, time.Minute, func() error { return nil }, EveryImmediately(), WithEveryJitter(0.5))
+
+		h.nextRun(t)
+		h.tick(59 * time.Second)
+		select {
+		case <-h.runs:
+			t.Fatal("ran before the interval")
+		case <-time.After(10 * time.Millisecond):
+		}
+
+		h.clock.Advance(31 * time.Second)
+		h.nextRun(t)
+	})
+
+	t.Run("invalid interval panics", func(t *testing.T) {
+		t.Parallel()
+		AssertPanicsMatch(t, func() {
+			Every(context.Background(), 0, func(context.Context) error { return nil })
+		}, "Every interval must be positive")
+	})
+}
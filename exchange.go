@@ -0,0 +1,171 @@
+package pocket
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+	"strings"
+)
+
+// ErrRateNotFound is returned by a RateProvider that has no rate for a currency pair.
+var ErrRateNotFound = errors.New("exchange rate not found")
+
+// ExchangeRate is the price of one unit of From expressed in To, stored as a scaled integer
+// to keep conversions exact: Rate=10842 with Scale=4 means 1 From = 1.0842 To.
+type ExchangeRate struct {
+	From  string
+	To    string
+	Rate  int64
+	Scale int
+}
+
+// NewExchangeRate returns an ExchangeRate converting from one currency to another.
+// The rate must be positive and the scale between 0 and 18, so that 10^scale fits in an int64.
+func NewExchangeRate(from, to string, rate int64, scale int) (ExchangeRate, error) {
+	if from == "" || to == "" {
+		return ExchangeRate{}, errors.New("exchange rate currencies must not be empty")
+	}
+	if rate <= 0 {
+		return ExchangeRate{}, fmt.Errorf("exchange rate must be positive, got %d", rate)
+	}
+	if scale < 0 || scale > 18 {
+		return ExchangeRate{}, fmt.Errorf("exchange rate scale must be between 0 and 18, got %d", scale)
+	}
+
+	return ExchangeRate{
+		From:  strings.ToUpper(from),
+		To:    strings.ToUpper(to),
+		Rate:  rate,
+		Scale: scale,
+	}, nil
+}
+
+// String returns the rate in major units, e.g. "1 EUR = 1.0842 USD".
+func (r ExchangeRate) String() string {
+	unit := int64(1)
+	for range r.Scale {
+		unit *= 10
+	}
+	if r.Scale == 0 {
+		return fmt.Sprintf("1 %s = %d %s", r.From, r.Rate, r.To)
+	}
+	return fmt.Sprintf("1 %s = %d.%0*d %s", r.From, r.Rate/unit, r.Scale, r.Rate%unit, r.To)
+}
+
+// Convert returns the money converted to the rate's target currency, keeping its precision.
+// Use Rescale afterwards when the target currency has a different number of minor units.
+// The result is rounded with the given mode. The product of amount and rate is computed in 128 bits,
+// so any rate scale works as long as the converted amount fits in an int64.
+// Returns an error if the money is not in the rate's source currency, the rate is invalid, or overflow occurs.
+func (m Money) Convert(rate ExchangeRate, mode RoundingMode) (Money, error) {
+	if !m.initialized {
+		return Money{}, errors.New("Money instances must be created with the constructor")
+	}
+	if m.currency != rate.From {
+		return Money{}, fmt.Errorf("cannot convert %s with a %s/%s rate", m.currency, rate.From, rate.To)
+	}
+	if rate.Rate <= 0 || rate.Scale < 0 || rate.Scale > 18 {
+		return Money{}, fmt.Errorf("invalid exchange rate %d with scale %d", rate.Rate, rate.Scale)
+	}
+
+	unit := int64(1)
+	for range rate.Scale {
+		unit *= 10
+	}
+	converted, err := mulDivRound(m.amount, rate.Rate, unit, mode)
+	if err != nil {
+		return Money{}, fmt.Errorf("cannot convert amount: %w", err)
+	}
+
+	return NewMoney(converted, rate.To, m.precision)
+}
+
+// mulDivRound returns a * b / c rounded with the given mode, for positive b and c.
+// The intermediate product is 128 bits wide, so only a result that doesn't fit in an int64 overflows.
+func mulDivRound(a, b, c int64, mode RoundingMode) (int64, error) {
+	negative := a < 0
+	absA := uint64(a)
+	if negative {
+		absA = -absA
+	}
+
+	hi, lo := bits.Mul64(absA, uint64(b))
+	if hi >= uint64(c) {
+		return 0, fmt.Errorf("integer overflow: %v * %v / %v", a, b, c)
+	}
+	quotient, remainder := bits.Div64(hi, lo, uint64(c))
+
+	if remainder != 0 {
+		half := cmp.Compare(remainder, uint64(c)-remainder)
+		awayFromZero, err := roundsAwayFromZero(mode, half, quotient%2 != 0, negative)
+		if err != nil {
+			return 0, err
+		}
+		if awayFromZero {
+			quotient++
+		}
+	}
+
+	if negative {
+		if quotient > 1<<63 {
+			return 0, fmt.Errorf("integer overflow: %v * %v / %v", a, b, c)
+		}
+		return int64(-quotient), nil
+	}
+	if quotient > math.MaxInt64 {
+		return 0, fmt.Errorf("integer overflow: %v * %v / %v", a, b, c)
+	}
+	return int64(quotient), nil
+}
+
+// RateProvider supplies exchange rates, e.g. from a live feed or a database,
+// while ConvertWith keeps the conversion arithmetic in one place.
+type RateProvider interface {
+	// Rate returns the rate converting from one currency to another.
+	// It should return an error wrapping ErrRateNotFound when it has no rate for the pair.
+	Rate(ctx context.Context, from, to string) (ExchangeRate, error)
+}
+
+// ConvertWith converts the money to the given currency using a rate fetched from the provider.
+// Converting to the money's own currency returns it unchanged without asking the provider.
+func ConvertWith(ctx context.Context, provider RateProvider, m Money, to string, mode RoundingMode) (Money, error) {
+	if !m.initialized {
+		return Money{}, errors.New("Money instances must be created with the constructor")
+	}
+	to = strings.ToUpper(to)
+	if m.currency == to {
+		return m, nil
+	}
+
+	rate, err := provider.Rate(ctx, m.currency, to)
+	if err != nil {
+		return Money{}, fmt.Errorf("cannot get %s/%s rate: %w", m.currency, to, err)
+	}
+	return m.Convert(rate, mode)
+}
+
+// StaticRates is a RateProvider backed by a fixed set of rates, useful for tests and offline use.
+type StaticRates struct {
+	rates map[[2]string]ExchangeRate
+}
+
+// NewStaticRates returns a StaticRates serving the given rates. A later rate for the same pair replaces an earlier one.
+func NewStaticRates(rates ...ExchangeRate) *StaticRates {
+	s := &StaticRates{rates: make(map[[2]string]ExchangeRate, len(rates))}
+	for _, rate := range rates {
+		s.rates[[2]string{rate.From, rate.To}] = rate
+	}
+	return s
+}
+
+// Rate implements RateProvider, returning ErrRateNotFound for unknown pairs.
+func (s *StaticRates) Rate(_ context.Context, from, to string) (ExchangeRate, error) {
+	rate, ok := s.rates[[2]string{from, to}]
+	if !ok {
+		return ExchangeRate{}, fmt.Errorf("%w: %s/%s", ErrRateNotFound, from, to)
+	}
+	return rate, nil
+}
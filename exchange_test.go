@@ -0,0 +1,139 @@
+package pocket
+
+import (
+	"context"
+	"math"
+	"testing"
+)
+
+func TestNewExchangeRate(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		rate    int64
+		scale   int
+		wantErr bool
+	}{
+		{name: "valid", from: "eur", to: "usd", rate: 10842, scale: 4},
+		{name: "zero scale", from: "USD", to: "ARS", rate: 1200, scale: 0},
+		{name: "empty currency", from: "", to: "USD", rate: 1, scale: 0, wantErr: true},
+		{name: "zero rate", from: "EUR", to: "USD", rate: 0, scale: 4, wantErr: true},
+		{name: "negative rate", from: "EUR", to: "USD", rate: -1, scale: 4, wantErr: true},
+		{name: "scale too large", from: "EUR", to: "USD", rate: 1, scale: 19, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rate, err := NewExchangeRate(tt.from, tt.to, tt.rate, tt.scale)
+			if tt.wantErr {
+				AssertNotNil(t, err)
+				return
+			}
+			AssertNil(t, err)
+			AssertEqual(t, rate.Rate, tt.rate)
+			AssertEqual(t, rate.Scale, tt.scale)
+		})
+	}
+
+	t.Run("uppercases currencies", func(t *testing.T) {
+		t.Parallel()
+		rate, err := NewExchangeRate("eur", "usd", 10842, 4)
+		AssertNil(t, err)
+		AssertEqual(t, rate.String(), "1 EUR = 1.0842 USD")
+	})
+}
+
+func TestMoney_Convert(t *testing.T) {
+	eurUSD, _ := NewExchangeRate("EUR", "USD", 10842, 4)
+	usdARS, _ := NewExchangeRate("USD", "ARS", 1200, 0)
+
+	tests := []struct {
+		name    string
+		amount  int64
+		rate    ExchangeRate
+		mode    RoundingMode
+		want    int64
+		wantErr bool
+	}{
+		{name: "scaled rate", amount: 100_00, rate: eurUSD, mode: RoundHalfUp, want: 108_42},
+		{name: "whole rate", amount: 10_00, rate: usdARS, mode: RoundHalfUp, want: 12000_00},
+		{name: "half-up", amount: 50, rate: eurUSD, mode: RoundHalfUp, want: 54},
+		{name: "floor", amount: 55, rate: eurUSD, mode: RoundFloor, want: 59},
+		{name: "ceil", amount: 55, rate: eurUSD, mode: RoundCeil, want: 60},
+		{name: "half-even", amount: 1_25, rate: ExchangeRate{From: "EUR", To: "USD", Rate: 5, Scale: 1}, mode: RoundHalfEven, want: 62},
+		{name: "negative amount", amount: -100_00, rate: eurUSD, mode: RoundHalfUp, want: -108_42},
+		{name: "negative amount floor", amount: -55, rate: eurUSD, mode: RoundFloor, want: -60},
+		{name: "max scale", amount: 1_000_000_000_00, rate: ExchangeRate{From: "EUR", To: "USD", Rate: 1_084_200_000_000_000_000, Scale: 18}, mode: RoundHalfUp, want: 1_084_200_000_00},
+		{name: "max scale near the int64 limit", amount: math.MaxInt64, rate: ExchangeRate{From: "EUR", To: "USD", Rate: 1_000_000_000_000_000_000, Scale: 18}, mode: RoundHalfUp, want: math.MaxInt64},
+		{name: "min int at max scale", amount: math.MinInt64, rate: ExchangeRate{From: "EUR", To: "USD", Rate: 1_000_000_000_000_000_000, Scale: 18}, mode: RoundHalfUp, want: math.MinInt64},
+		{name: "result overflows int64", amount: math.MaxInt64, rate: eurUSD, mode: RoundHalfUp, wantErr: true},
+		{name: "rounding overflows int64", amount: math.MaxInt64, rate: ExchangeRate{From: "EUR", To: "USD", Rate: 10_001, Scale: 4}, mode: RoundCeil, wantErr: true},
+		{name: "invalid rate", amount: 100_00, rate: ExchangeRate{From: "EUR", To: "USD"}, mode: RoundHalfUp, wantErr: true},
+		{name: "unknown rounding mode", amount: 55, rate: eurUSD, mode: RoundingMode(42), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m, _ := NewMoney(tt.amount, tt.rate.From, 2)
+			result, err := m.Convert(tt.rate, tt.mode)
+			if tt.wantErr {
+				AssertNotNil(t, err)
+				return
+			}
+			AssertNil(t, err)
+			AssertEqual(t, result.Amount(), tt.want)
+			AssertEqual(t, result.Currency(), tt.rate.To)
+			AssertEqual(t, result.Precision(), 2)
+		})
+	}
+
+	t.Run("currency mismatch", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewARS(100_00).Convert(eurUSD, RoundHalfUp)
+		AssertNotNil(t, err)
+	})
+}
+
+func TestConvertWith(t *testing.T) {
+	eurUSD, _ := NewExchangeRate("EUR", "USD", 10842, 4)
+	provider := NewStaticRates(eurUSD)
+	eur, _ := NewMoney(100_00, "EUR", 2)
+
+	t.Run("uses provider rate", func(t *testing.T) {
+		t.Parallel()
+		result, err := ConvertWith(context.Background(), provider, eur, "USD", RoundHalfUp)
+		AssertNil(t, err)
+		AssertEqual(t, result.Format(), "108.42 USD")
+	})
+
+	t.Run("same currency", func(t *testing.T) {
+		t.Parallel()
+		result, err := ConvertWith(context.Background(), provider, eur, "EUR", RoundHalfUp)
+		AssertNil(t, err)
+		AssertTrue(t, result.Equals(eur))
+	})
+
+	t.Run("lowercase target currency", func(t *testing.T) {
+		t.Parallel()
+		result, err := ConvertWith(context.Background(), provider, eur, "usd", RoundHalfUp)
+		AssertNil(t, err)
+		AssertEqual(t, result.Format(), "108.42 USD")
+
+		result, err = ConvertWith(context.Background(), provider, eur, "eur", RoundHalfUp)
+		AssertNil(t, err)
+		AssertTrue(t, result.Equals(eur))
+	})
+
+	t.Run("zero money", func(t *testing.T) {
+		t.Parallel()
+		_, err := ConvertWith(context.Background(), provider, Money{}, "", RoundHalfUp)
+		AssertNotNil(t, err)
+	})
+
+	t.Run("missing rate", func(t *testing.T) {
+		t.Parallel()
+		_, err := ConvertWith(context.Background(), provider, eur, "ARS", RoundHalfUp)
+		AssertErrorIs(t, err, ErrRateNotFound)
+	})
+}
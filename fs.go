@@ -0,0 +1,84 @@
+package pocket
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// FileExists reports whether path exists and is not a directory.
+// It returns false if path cannot be checked, e.g. for lack of permissions.
+func FileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+// DirExists reports whether path exists and is a directory.
+// It returns false if path cannot be checked, e.g. for lack of permissions.
+func DirExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.IsDir()
+}
+
+// EnsureDir creates the directory at path, along with any missing parents, with the given permissions.
+// It does nothing if the directory already exists, and returns an error if path exists but is not a directory.
+func EnsureDir(path string, perm os.FileMode) error {
+	if err := os.MkdirAll(path, perm); err != nil {
+		return fmt.Errorf("cannot create directory %s: %w", path, err)
+	}
+	return nil
+}
+
+// Touch creates an empty file at path if it does not exist, or sets its modification time to now if it does.
+func Touch(path string) error {
+	return TouchWithClock(RealClock{}, path)
+}
+
+// TouchWithClock works like Touch, reading the time from clock.
+func TouchWithClock(clock Clock, path string) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	now := clock.Now()
+	return os.Chtimes(path, now, now)
+}
+
+// CopyFile copies the contents and permissions of the file at src to dst, replacing dst if it exists.
+// The data is synced to disk before returning, so the copy survives a crash.
+func CopyFile(src, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	info, err := in.Stat()
+	if err != nil {
+		return err
+	}
+	if info.IsDir() {
+		return fmt.Errorf("cannot copy %s: is a directory", src)
+	}
+
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err = errors.Join(err, out.Close())
+	}()
+
+	if _, err := io.Copy(out, in); err != nil {
+		return fmt.Errorf("cannot copy %s to %s: %w", src, dst, err)
+	}
+	// The umask may have narrowed the permissions of a new file, and an existing one keeps its own.
+	if err := out.Chmod(info.Mode().Perm()); err != nil {
+		return err
+	}
+	return out.Sync()
+}
@@ -0,0 +1,118 @@
+package pocket
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestFileExistsAndDirExists(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	file := filepath.Join(dir, "file.txt")
+	AssertNil(t, os.WriteFile(file, []byte("hi"), 0o600))
+	missing := filepath.Join(dir, "missing")
+
+	AssertTrue(t, FileExists(file))
+	AssertFalse(t, FileExists(dir))
+	AssertFalse(t, FileExists(missing))
+
+	AssertTrue(t, DirExists(dir))
+	AssertFalse(t, DirExists(file))
+	AssertFalse(t, DirExists(missing))
+}
+
+func TestEnsureDir(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+
+	nested := filepath.Join(dir, "a", "b", "c")
+	AssertNil(t, EnsureDir(nested, 0o755))
+	AssertTrue(t, DirExists(nested))
+	AssertNil(t, EnsureDir(nested, 0o755))
+
+	file := filepath.Join(dir, "file.txt")
+	AssertNil(t, os.WriteFile(file, nil, 0o600))
+	AssertNotNil(t, EnsureDir(file, 0o755))
+}
+
+func TestTouch(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "marker")
+
+	AssertNil(t, Touch(path))
+	AssertTrue(t, FileExists(path))
+
+	old := time.Now().Add(-time.Hour)
+	AssertNil(t, os.Chtimes(path, old, old))
+	AssertNil(t, os.WriteFile(path, []byte("keep"), 0o644))
+	AssertNil(t, os.Chtimes(path, old, old))
+
+	AssertNil(t, Touch(path))
+	info, err := os.Stat(path)
+	AssertNil(t, err)
+	AssertTrue(t, info.ModTime().After(old.Add(30*time.Minute)))
+
+	data, err := os.ReadFile(path)
+	AssertNil(t, err)
+	AssertEqual(t, string(data), "keep")
+}
+
+func TestTouchWithClock(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "marker")
+	clock := NewFakeClock(epoch)
+
+	AssertNil(t, TouchWithClock(clock, path))
+	info, err := os.Stat(path)
+	AssertNil(t, err)
+	AssertTrue(t, info.ModTime().Equal(epoch))
+
+	clock.Advance(time.Hour)
+	AssertNil(t, TouchWithClock(clock, path))
+	info, err = os.Stat(path)
+	AssertNil(t, err)
+	AssertTrue(t, info.ModTime().Equal(epoch.Add(time.Hour)))
+}
+
+func TestCopyFile(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	src := filepath.Join(dir, "src.sh")
+	AssertNil(t, os.WriteFile(src, []byte("#!/bin/sh\necho hi\n"), 0o700))
+
+	t.Run("copies contents and permissions", func(t *testing.T) {
+		t.Parallel()
+		dst := filepath.Join(dir, "dst.sh")
+		AssertNil(t, CopyFile(src, dst))
+
+		data, err := os.ReadFile(dst)
+		AssertNil(t, err)
+		AssertEqual(t, string(data), "#!/bin/sh\necho hi\n")
+
+		if runtime.GOOS != "windows" {
+			info, err := os.Stat(dst)
+			AssertNil(t, err)
+			AssertEqual(t, info.Mode().Perm(), os.FileMode(0o700))
+		}
+	})
+
+	t.Run("replaces existing file", func(t *testing.T) {
+		t.Parallel()
+		dst := filepath.Join(dir, "existing.sh")
+		AssertNil(t, os.WriteFile(dst, []byte("a much longer previous content"), 0o644))
+		AssertNil(t, CopyFile(src, dst))
+
+		data, err := os.ReadFile(dst)
+		AssertNil(t, err)
+		AssertEqual(t, string(data), "#!/bin/sh\necho hi\n")
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		t.Parallel()
+		AssertErrorIs(t, CopyFile(filepath.Join(dir, "missing"), filepath.Join(dir, "x")), os.ErrNotExist)
+		AssertNotNil(t, CopyFile(dir, filepath.Join(dir, "y")))
+	})
+}
@@ -1,3 +1,10 @@
 module github.com/germanDV/pocket
 
 go 1.25.6
+
+require (
+	golang.org/x/crypto v0.55.0
+	golang.org/x/text v0.41.0
+)
+
+require golang.org/x/sys v0.47.0 // indirect
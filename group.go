@@ -0,0 +1,110 @@
+package pocket
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Group runs functions in goroutines and waits for them, optionally bounding how many run at once.
+// By default, the first error cancels the context passed to the other functions and is the one Wait returns.
+// It covers the common case of golang.org/x/sync/errgroup without the dependency.
+type Group struct {
+	ctx        context.Context
+	cancel     context.CancelCauseFunc
+	collectAll bool
+
+	wg  sync.WaitGroup
+	sem chan struct{}
+
+	mu   sync.Mutex
+	errs []error
+}
+
+// GroupOption configures a Group.
+type GroupOption func(*Group)
+
+// WithCollectErrors makes a Group run every function to completion, without cancelling on failure,
+// and return all their errors joined with errors.Join.
+func WithCollectErrors() GroupOption {
+	return func(g *Group) {
+		g.collectAll = true
+	}
+}
+
+// NewGroup returns a Group whose functions receive a context derived from ctx.
+// That context is cancelled on the first error (unless WithCollectErrors is used) or when Wait returns.
+func NewGroup(ctx context.Context, opts ...GroupOption) *Group {
+	g := &Group{}
+	g.ctx, g.cancel = context.WithCancelCause(ctx)
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// SetLimit bounds the number of functions running at once to n; Go blocks while the limit is reached.
+// A negative n removes the limit. It panics if called while functions are running.
+func (g *Group) SetLimit(n int) {
+	if n < 0 {
+		g.sem = nil
+		return
+	}
+	if len(g.sem) != 0 {
+		panic(fmt.Sprintf("cannot change the group limit while %d function(s) are running", len(g.sem)))
+	}
+	g.sem = make(chan struct{}, n)
+}
+
+// Go runs fn in a new goroutine, waiting first for a free slot if a limit is set.
+// A panic in fn is reported as an error.
+func (g *Group) Go(fn func(ctx context.Context) error) {
+	if g.sem != nil {
+		g.sem <- struct{}{}
+	}
+
+	g.wg.Go(func() {
+		if g.sem != nil {
+			defer func() { <-g.sem }()
+		}
+		if err := g.call(fn); err != nil {
+			g.fail(err)
+		}
+	})
+}
+
+// Wait blocks until every function has returned, then cancels the group's context.
+// It returns the first error, or all of them joined when the Group collects errors.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	g.cancel(context.Canceled)
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.collectAll {
+		return errors.Join(g.errs...)
+	}
+	if len(g.errs) > 0 {
+		return g.errs[0]
+	}
+	return nil
+}
+
+func (g *Group) call(fn func(ctx context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("group function panicked: %v", r)
+		}
+	}()
+	return fn(g.ctx)
+}
+
+func (g *Group) fail(err error) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	g.errs = append(g.errs, err)
+	if !g.collectAll && len(g.errs) == 1 {
+		g.cancel(err)
+	}
+}
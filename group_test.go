@@ -0,0 +1,132 @@
+package pocket
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGroup(t *testing.T) {
+	t.Run("waits for all functions", func(t *testing.T) {
+		t.Parallel()
+		g := NewGroup(context.Background())
+		var done atomic.Int32
+		for range 10 {
+			g.Go(func(context.Context) error {
+				time.Sleep(time.Millisecond)
+				done.Add(1)
+				return nil
+			})
+		}
+		AssertNil(t, g.Wait())
+		AssertEqual(t, done.Load(), int32(10))
+	})
+
+	t.Run("first error cancels the others", func(t *testing.T) {
+		t.Parallel()
+		errFailed := errors.New("failed")
+		g := NewGroup(context.Background())
+		g.Go(func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+		g.Go(func(context.Context) error { return errFailed })
+
+		AssertEqual(t, g.Wait(), errFailed)
+	})
+
+	t.Run("context carries the first error as cause", func(t *testing.T) {
+		t.Parallel()
+		errFailed := errors.New("failed")
+		g := NewGroup(context.Background())
+		var cause error
+		g.Go(func(ctx context.Context) error {
+			<-ctx.Done()
+			cause = context.Cause(ctx)
+			return nil
+		})
+		g.Go(func(context.Context) error { return errFailed })
+
+		AssertEqual(t, g.Wait(), errFailed)
+		AssertEqual(t, cause, errFailed)
+	})
+
+	t.Run("collects all errors", func(t *testing.T) {
+		t.Parallel()
+		errA, errB := errors.New("a"), errors.New("b")
+		g := NewGroup(context.Background(), WithCollectErrors())
+		var cancelled atomic.Bool
+		g.Go(func(context.Context) error { return errA })
+		g.Go(func(ctx context.Context) error {
+			time.Sleep(5 * time.Millisecond)
+			cancelled.Store(ctx.Err() != nil)
+			return errB
+		})
+
+		err := g.Wait()
+		AssertErrorIs(t, err, errA)
+		AssertErrorIs(t, err, errB)
+		AssertFalse(t, cancelled.Load())
+	})
+
+	t.Run("limit bounds concurrency", func(t *testing.T) {
+		t.Parallel()
+		g := NewGroup(context.Background())
+		g.SetLimit(3)
+		var running, peak atomic.Int32
+		for range 20 {
+			g.Go(func(context.Context) error {
+				n := running.Add(1)
+				for {
+					p := peak.Load()
+					if n <= p || peak.CompareAndSwap(p, n) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				running.Add(-1)
+				return nil
+			})
+		}
+		AssertNil(t, g.Wait())
+		AssertLessOrEqual(t, peak.Load(), int32(3))
+	})
+
+	t.Run("panic becomes an error", func(t *testing.T) {
+		t.Parallel()
+		g := NewGroup(context.Background())
+		g.Go(func(context.Context) error { panic("boom") })
+
+		err := g.Wait()
+		AssertNotNil(t, err)
+		AssertContains(t, err.Error(), "group function panicked: boom")
+	})
+
+	t.Run("wait cancels the context", func(t *testing.T) {
+		t.Parallel()
+		g := NewGroup(context.Background())
+		var ctx context.Context
+		g.Go(func(c context.Context) error {
+			ctx = c
+			return nil
+		})
+		AssertNil(t, g.Wait())
+		AssertErrorIs(t, ctx.Err(), context.Canceled)
+	})
+
+	t.Run("changing the limit while running panics", func(t *testing.T) {
+		t.Parallel()
+		g := NewGroup(context.Background())
+		g.SetLimit(1)
+		release := make(chan struct{})
+		g.Go(func(context.Context) error {
+			<-release
+			return nil
+		})
+		AssertPanicsMatch(t, func() { g.SetLimit(2) }, "cannot change the group limit")
+		close(release)
+		AssertNil(t, g.Wait())
+	})
+}
@@ -0,0 +1,49 @@
+package pocket
+
+import (
+	"crypto"
+	_ "crypto/md5"  // registers crypto.MD5 for legacy checksums
+	_ "crypto/sha1" // registers crypto.SHA1 for legacy checksums
+	"crypto/sha256"
+	_ "crypto/sha512" // registers crypto.SHA384 and crypto.SHA512
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// SHA256Hex returns the SHA-256 digest of data as a lowercase hex string.
+func SHA256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// HashReader reads r to the end and returns its digest with the given algorithm (e.g. crypto.SHA256) as a lowercase hex string.
+// It streams the data, so it works for inputs of any size.
+func HashReader(r io.Reader, algo crypto.Hash) (string, error) {
+	if !algo.Available() {
+		return "", fmt.Errorf("hash algorithm %v is not available", algo)
+	}
+	h := algo.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", fmt.Errorf("cannot read data to hash: %w", err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// HashFile returns the digest of the file at path with the given algorithm as a lowercase hex string.
+func HashFile(path string, algo crypto.Hash) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	return HashReader(f, algo)
+}
+
+// EqualHex reports whether two hex digests are equal, ignoring case, using SafeCompare.
+// Use it to check checksums and signatures without leaking timing information.
+func EqualHex(a, b string) bool {
+	return SafeCompare(strings.ToLower(a), strings.ToLower(b))
+}
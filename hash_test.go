@@ -0,0 +1,79 @@
+package pocket
+
+import (
+	"crypto"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const helloSHA256 = "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824"
+
+func TestSHA256Hex(t *testing.T) {
+	t.Parallel()
+	AssertEqual(t, SHA256Hex([]byte("hello")), helloSHA256)
+	AssertEqual(t, SHA256Hex(nil), "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")
+}
+
+type failingReader struct{}
+
+func (failingReader) Read([]byte) (int, error) { return 0, errors.New("disk on fire") }
+
+func TestHashReader(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		algo crypto.Hash
+		want string
+	}{
+		{crypto.SHA256, helloSHA256},
+		{crypto.MD5, "5d41402abc4b2a76b9719d911017c592"},
+		{crypto.SHA1, "aaf4c61ddcc5e8a2dabede0f3b482cd9aea9434d"},
+		{crypto.SHA512, "9b71d224bd62f3785d96d46ad3ea3d73319bfbc2890caadae2dff72519673ca72323c3d99ba5c11d7c7acc6e14b8c5da0c4663475c2e5c3adef46f73bcdec043"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.algo.String(), func(t *testing.T) {
+			t.Parallel()
+			got, err := HashReader(strings.NewReader("hello"), tt.algo)
+			AssertNil(t, err)
+			AssertEqual(t, got, tt.want)
+		})
+	}
+
+	t.Run("read error", func(t *testing.T) {
+		t.Parallel()
+		_, err := HashReader(failingReader{}, crypto.SHA256)
+		AssertNotNil(t, err)
+		AssertContains(t, err.Error(), "disk on fire")
+	})
+
+	t.Run("unavailable algorithm", func(t *testing.T) {
+		t.Parallel()
+		_, err := HashReader(strings.NewReader("hello"), crypto.MD4)
+		AssertNotNil(t, err)
+	})
+}
+
+func TestHashFile(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join(t.TempDir(), "artifact.txt")
+	AssertNil(t, os.WriteFile(path, []byte("hello"), 0o600))
+
+	got, err := HashFile(path, crypto.SHA256)
+	AssertNil(t, err)
+	AssertEqual(t, got, helloSHA256)
+
+	_, err = HashFile(filepath.Join(t.TempDir(), "missing"), crypto.SHA256)
+	AssertErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestEqualHex(t *testing.T) {
+	t.Parallel()
+	AssertTrue(t, EqualHex(helloSHA256, helloSHA256))
+	AssertTrue(t, EqualHex(helloSHA256, strings.ToUpper(helloSHA256)))
+	AssertFalse(t, EqualHex(helloSHA256, SHA256Hex([]byte("world"))))
+	AssertFalse(t, EqualHex(helloSHA256, helloSHA256[:10]))
+}
@@ -0,0 +1,164 @@
+package pocket
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Pluralize returns singular if count is 1 (or -1), and plural otherwise.
+// An empty plural defaults to singular + "s".
+func Pluralize(count int, singular, plural string) string {
+	if count == 1 || count == -1 {
+		return singular
+	}
+	if plural == "" {
+		return singular + "s"
+	}
+	return plural
+}
+
+// HumanizeCount returns the count followed by the right form of the noun,
+// abbreviating large counts with k, M and B suffixes, e.g. "1 item", "3 items", "1.2k items".
+// An empty plural defaults to singular + "s".
+func HumanizeCount(count int, singular, plural string) string {
+	return compactNumber(count) + " " + Pluralize(count, singular, plural)
+}
+
+// compactNumber formats n with at most one decimal and a k, M or B suffix once it reaches a thousand.
+func compactNumber(n int) string {
+	if n > -1000 && n < 1000 {
+		return strconv.Itoa(n)
+	}
+
+	sign := ""
+	f := float64(n)
+	if f < 0 {
+		sign, f = "-", -f
+	}
+
+	suffixes := []string{"k", "M", "B", "T"}
+	i := 0
+	f /= 1000
+	// Promote when rounding would print 1000.0k instead of 1M.
+	for i < len(suffixes)-1 && f >= 999.95 {
+		f /= 1000
+		i++
+	}
+
+	s := strconv.FormatFloat(f, 'f', 1, 64)
+	return sign + strings.TrimSuffix(s, ".0") + suffixes[i]
+}
+
+// HumanizeBytes formats a byte count using binary (IEC) units, e.g. 3565158 → "3.4 MiB".
+func HumanizeBytes(n int64) string {
+	sign := ""
+	u := uint64(n)
+	if n < 0 {
+		sign, u = "-", uint64(-(n+1))+1
+	}
+	if u < 1024 {
+		return sign + strconv.FormatUint(u, 10) + " B"
+	}
+
+	units := []string{"KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+	f := float64(u) / 1024
+	i := 0
+	for i < len(units)-1 && f >= 1023.95 {
+		f /= 1024
+		i++
+	}
+
+	s := strconv.FormatFloat(f, 'f', 1, 64)
+	return sign + strings.TrimSuffix(s, ".0") + " " + units[i]
+}
+
+var byteUnits = map[string]float64{
+	"":    1,
+	"b":   1,
+	"k":   1e3,
+	"kb":  1e3,
+	"m":   1e6,
+	"mb":  1e6,
+	"g":   1e9,
+	"gb":  1e9,
+	"t":   1e12,
+	"tb":  1e12,
+	"p":   1e15,
+	"pb":  1e15,
+	"ki":  1 << 10,
+	"kib": 1 << 10,
+	"mi":  1 << 20,
+	"mib": 1 << 20,
+	"gi":  1 << 30,
+	"gib": 1 << 30,
+	"ti":  1 << 40,
+	"tib": 1 << 40,
+	"pi":  1 << 50,
+	"pib": 1 << 50,
+}
+
+// ParseBytes parses a human-readable byte size such as "512MB", "1.5 GiB" or "100".
+// SI units (kB, MB, GB...) are powers of 1000 and IEC units (KiB, MiB, GiB...) are powers of 1024.
+// Units are case-insensitive and the "B" may be omitted.
+func ParseBytes(s string) (int64, error) {
+	s = strings.TrimSpace(s)
+	i := strings.IndexFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if i < 0 {
+		i = len(s)
+	}
+
+	number, unit := s[:i], strings.ToLower(strings.TrimSpace(s[i:]))
+	f, err := strconv.ParseFloat(number, 64)
+	if err != nil {
+		return 0, fmt.Errorf("cannot parse %q as byte size: invalid number", s)
+	}
+
+	multiplier, ok := byteUnits[unit]
+	if !ok {
+		return 0, fmt.Errorf("cannot parse %q as byte size: unknown unit %q", s, unit)
+	}
+
+	bytes := f * multiplier
+	if bytes >= math.MaxInt64 {
+		return 0, fmt.Errorf("cannot parse %q as byte size: integer overflow", s)
+	}
+	return int64(bytes), nil
+}
+
+// ByteSize is a number of bytes.
+// The config loader parses ByteSize fields with ParseBytes, so values like "10MiB" can be used in env vars and defaults.
+type ByteSize int64
+
+// String formats the size with HumanizeBytes.
+func (b ByteSize) String() string {
+	return HumanizeBytes(int64(b))
+}
+
+func init() {
+	RegisterConfigParser(func(s string) (ByteSize, error) {
+		n, err := ParseBytes(s)
+		return ByteSize(n), err
+	})
+}
+
+// HumanizeNumber formats n with comma thousands separators, e.g. 1234567 → "1,234,567".
+func HumanizeNumber(n int64) string {
+	s := strconv.FormatInt(n, 10)
+	sign := ""
+	if n < 0 {
+		sign, s = "-", s[1:]
+	}
+
+	var sb strings.Builder
+	for i, c := range s {
+		if i > 0 && (len(s)-i)%3 == 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteRune(c)
+	}
+	return sign + sb.String()
+}
@@ -0,0 +1,149 @@
+package pocket
+
+import (
+	"math"
+	"testing"
+)
+
+func TestPluralize(t *testing.T) {
+	tests := []struct {
+		count int
+		want  string
+	}{
+		{count: 0, want: "children"},
+		{count: 1, want: "child"},
+		{count: -1, want: "child"},
+		{count: 2, want: "children"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, Pluralize(tt.count, "child", "children"), tt.want)
+		})
+	}
+
+	t.Run("defaults plural", func(t *testing.T) {
+		AssertEqual(t, Pluralize(2, "item", ""), "items")
+		AssertEqual(t, Pluralize(1, "item", ""), "item")
+	})
+}
+
+func TestHumanizeCount(t *testing.T) {
+	tests := []struct {
+		count int
+		want  string
+	}{
+		{count: 0, want: "0 items"},
+		{count: 1, want: "1 item"},
+		{count: 3, want: "3 items"},
+		{count: 999, want: "999 items"},
+		{count: 1000, want: "1k items"},
+		{count: 1234, want: "1.2k items"},
+		{count: 15_500, want: "15.5k items"},
+		{count: 999_999, want: "1M items"},
+		{count: 2_500_000, want: "2.5M items"},
+		{count: 7_000_000_000, want: "7B items"},
+		{count: -4200, want: "-4.2k items"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, HumanizeCount(tt.count, "item", ""), tt.want)
+		})
+	}
+}
+
+func TestHumanizeBytes(t *testing.T) {
+	tests := []struct {
+		input int64
+		want  string
+	}{
+		{input: 0, want: "0 B"},
+		{input: 1023, want: "1023 B"},
+		{input: 1024, want: "1 KiB"},
+		{input: 1536, want: "1.5 KiB"},
+		{input: 3_565_158, want: "3.4 MiB"},
+		{input: 1<<20 - 1, want: "1 MiB"},
+		{input: 5 << 30, want: "5 GiB"},
+		{input: -2048, want: "-2 KiB"},
+		{input: math.MaxInt64, want: "8 EiB"},
+		{input: math.MinInt64, want: "-8 EiB"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, HumanizeBytes(tt.input), tt.want)
+		})
+	}
+}
+
+func TestParseBytes(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int64
+	}{
+		{input: "100", want: 100},
+		{input: "100B", want: 100},
+		{input: "512MB", want: 512_000_000},
+		{input: "512mb", want: 512_000_000},
+		{input: "1.5 GiB", want: 1_610_612_736},
+		{input: "10Ki", want: 10_240},
+		{input: "2k", want: 2000},
+		{input: " 4 TiB ", want: 4 << 40},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseBytes(tt.input)
+			AssertNil(t, err)
+			AssertEqual(t, got, tt.want)
+		})
+	}
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		for _, input := range []string{"", "MB", "-5MB", "1.2.3MB", "5XB", "10000PiB"} {
+			_, err := ParseBytes(input)
+			AssertNotNil(t, err, input)
+		}
+	})
+
+	t.Run("works with the config loader", func(t *testing.T) {
+		type MyConfig struct {
+			MaxUpload ByteSize `env:"MAX_UPLOAD" default:"10MiB"`
+		}
+
+		myConfig, err := LoadConfig[MyConfig](mapSource{})
+		AssertNil(t, err)
+		AssertEqual(t, myConfig.MaxUpload, ByteSize(10<<20))
+		AssertEqual(t, myConfig.MaxUpload.String(), "10 MiB")
+
+		_, err = LoadConfig[MyConfig](mapSource{"MAX_UPLOAD": "lots"})
+		AssertNotNil(t, err)
+	})
+}
+
+func TestHumanizeNumber(t *testing.T) {
+	tests := []struct {
+		input int64
+		want  string
+	}{
+		{input: 0, want: "0"},
+		{input: 999, want: "999"},
+		{input: 1000, want: "1,000"},
+		{input: 1_234_567, want: "1,234,567"},
+		{input: -1_234_567, want: "-1,234,567"},
+		{input: -100, want: "-100"},
+		{input: math.MinInt64, want: "-9,223,372,036,854,775,808"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, HumanizeNumber(tt.input), tt.want)
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package pocket
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const (
+	idTimestampBits   = 41
+	idDefaultNodeBits = 10
+)
+
+// DefaultIDEpoch is the epoch IDGenerator timestamps count from unless WithIDEpoch is used.
+var DefaultIDEpoch = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// ID is a 64-bit, time-ordered identifier produced by an IDGenerator.
+// It fits a BIGINT primary key and its string form is Base58, which is shorter and URL-safe.
+type ID int64
+
+// ParseID parses the Base58 string form of an ID.
+func ParseID(s string) (ID, error) {
+	b, err := DecodeBase58(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid ID %q: %w", s, err)
+	}
+	if len(b) != 8 || b[0]&0x80 != 0 {
+		return 0, fmt.Errorf("invalid ID %q: not a 63-bit value", s)
+	}
+	return ID(binary.BigEndian.Uint64(b)), nil
+}
+
+// String returns the Base58 form of the ID.
+func (id ID) String() string {
+	return EncodeBase58(binary.BigEndian.AppendUint64(nil, uint64(id)))
+}
+
+// MarshalText implements encoding.TextMarshaler using the string form,
+// which also keeps IDs intact in JSON consumers that lose precision on large numbers.
+func (id ID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseID.
+func (id *ID) UnmarshalText(text []byte) error {
+	parsed, err := ParseID(string(text))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// IDGenerator produces snowflake-style IDs: a 41-bit millisecond timestamp since an epoch,
+// followed by the node number and a per-millisecond sequence, so IDs from different nodes never collide
+// and sort by creation time. It is safe for concurrent use.
+//
+// If the clock goes backwards, the generator keeps counting from the last timestamp it used instead of
+// producing duplicates, and when a millisecond's sequence is exhausted it moves on to the next one.
+type IDGenerator struct {
+	node     int64
+	nodeBits uint
+	seqBits  uint
+	epoch    time.Time
+	clock    Clock
+
+	mu     sync.Mutex
+	lastMs int64
+	seq    int64
+}
+
+// IDGeneratorOption configures an IDGenerator.
+type IDGeneratorOption func(*IDGenerator)
+
+// WithIDNodeBits sets how many of the 22 bits after the timestamp identify the node; the rest hold the sequence.
+// Defaults to 10 bits (1024 nodes, 4096 IDs per millisecond per node).
+func WithIDNodeBits(bits uint) IDGeneratorOption {
+	return func(g *IDGenerator) {
+		g.nodeBits = bits
+	}
+}
+
+// WithIDEpoch sets the time IDGenerator timestamps count from. Defaults to DefaultIDEpoch.
+// Every generator of a system must use the same epoch.
+func WithIDEpoch(epoch time.Time) IDGeneratorOption {
+	return func(g *IDGenerator) {
+		g.epoch = epoch
+	}
+}
+
+// WithIDClock sets the clock the generator reads the time from. Defaults to RealClock.
+func WithIDClock(clock Clock) IDGeneratorOption {
+	return func(g *IDGenerator) {
+		g.clock = clock
+	}
+}
+
+// NewIDGenerator returns an IDGenerator for the given node number.
+// It returns an error if the node bits leave no room for a sequence or node does not fit in them.
+func NewIDGenerator(node int64, opts ...IDGeneratorOption) (*IDGenerator, error) {
+	g := &IDGenerator{
+		node:     node,
+		nodeBits: idDefaultNodeBits,
+		epoch:    DefaultIDEpoch,
+		clock:    RealClock{},
+		lastMs:   -1,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	const available = 63 - idTimestampBits
+	if g.nodeBits >= available {
+		return nil, fmt.Errorf("node bits must be less than %d, got %d", available, g.nodeBits)
+	}
+	if maxNode := int64(1)<<g.nodeBits - 1; node < 0 || node > maxNode {
+		return nil, fmt.Errorf("node must be between 0 and %d, got %d", maxNode, node)
+	}
+	g.seqBits = available - g.nodeBits
+	return g, nil
+}
+
+// Next returns a new ID, greater than every ID the generator returned before.
+// It panics if the clock is before the epoch or the 41-bit timestamp is exhausted (about 69 years after the epoch).
+func (g *IDGenerator) Next() ID {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ms := g.clock.Now().Sub(g.epoch).Milliseconds()
+	if ms < 0 {
+		panic(fmt.Sprintf("clock is before the ID epoch %s", g.epoch.Format(time.RFC3339)))
+	}
+
+	if ms <= g.lastMs {
+		// Same millisecond or the clock went backwards: continue the last sequence.
+		ms = g.lastMs
+		g.seq++
+		if g.seq == 1<<g.seqBits {
+			ms++
+			g.seq = 0
+		}
+	} else {
+		g.seq = 0
+	}
+	if ms >= 1<<idTimestampBits {
+		panic("ID timestamp overflow")
+	}
+	g.lastMs = ms
+
+	return ID(ms<<(g.nodeBits+g.seqBits) | g.node<<g.seqBits | g.seq)
+}
+
+// Time returns the creation time encoded in an ID from this generator, with millisecond precision.
+func (g *IDGenerator) Time(id ID) time.Time {
+	return g.epoch.Add(time.Duration(int64(id)>>(g.nodeBits+g.seqBits)) * time.Millisecond)
+}
+
+// Node returns the node number encoded in an ID from this generator.
+func (g *IDGenerator) Node(id ID) int64 {
+	return int64(id) >> g.seqBits & (1<<g.nodeBits - 1)
+}
@@ -0,0 +1,146 @@
+package pocket
+
+import (
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestIDGenerator(t *testing.T) {
+	t.Run("encodes time and node", func(t *testing.T) {
+		t.Parallel()
+		clock := NewFakeClock(epoch.Add(1500 * time.Millisecond))
+		g, err := NewIDGenerator(7, WithIDClock(clock))
+		AssertNil(t, err)
+
+		id := g.Next()
+		AssertEqual(t, g.Time(id), epoch.Add(1500*time.Millisecond))
+		AssertEqual(t, g.Node(id), int64(7))
+	})
+
+	t.Run("increasing within and across milliseconds", func(t *testing.T) {
+		t.Parallel()
+		clock := NewFakeClock(epoch)
+		g, err := NewIDGenerator(1, WithIDClock(clock))
+		AssertNil(t, err)
+
+		a, b := g.Next(), g.Next()
+		clock.Advance(time.Millisecond)
+		c := g.Next()
+		AssertLess(t, a, b)
+		AssertLess(t, b, c)
+	})
+
+	t.Run("clock going backwards", func(t *testing.T) {
+		t.Parallel()
+		clock := NewFakeClock(epoch)
+		g, err := NewIDGenerator(1, WithIDClock(clock))
+		AssertNil(t, err)
+
+		a := g.Next()
+		clock.Advance(-time.Second)
+		b := g.Next()
+		AssertLess(t, a, b)
+		AssertEqual(t, g.Time(b), epoch)
+	})
+
+	t.Run("sequence overflow moves to the next millisecond", func(t *testing.T) {
+		t.Parallel()
+		clock := NewFakeClock(epoch)
+		g, err := NewIDGenerator(0, WithIDClock(clock), WithIDNodeBits(20)) // 2 sequence bits
+		AssertNil(t, err)
+
+		ids := make([]ID, 5)
+		for i := range ids {
+			ids[i] = g.Next()
+		}
+		AssertSorted(t, ids)
+		AssertEqual(t, g.Time(ids[3]), epoch)
+		AssertEqual(t, g.Time(ids[4]), epoch.Add(time.Millisecond))
+	})
+
+	t.Run("unique across goroutines", func(t *testing.T) {
+		t.Parallel()
+		g, err := NewIDGenerator(3)
+		AssertNil(t, err)
+
+		var mu sync.Mutex
+		seen := map[ID]bool{}
+		var wg sync.WaitGroup
+		for range 8 {
+			wg.Go(func() {
+				for range 1000 {
+					id := g.Next()
+					mu.Lock()
+					seen[id] = true
+					mu.Unlock()
+				}
+			})
+		}
+		wg.Wait()
+		AssertEqual(t, len(seen), 8000)
+	})
+
+	t.Run("custom epoch", func(t *testing.T) {
+		t.Parallel()
+		custom := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+		g, err := NewIDGenerator(0, WithIDClock(NewFakeClock(epoch)), WithIDEpoch(custom))
+		AssertNil(t, err)
+		AssertEqual(t, g.Time(g.Next()), epoch)
+	})
+
+	t.Run("clock before epoch panics", func(t *testing.T) {
+		t.Parallel()
+		g, err := NewIDGenerator(0, WithIDClock(NewFakeClock(DefaultIDEpoch.Add(-time.Hour))))
+		AssertNil(t, err)
+		AssertPanicsMatch(t, func() { g.Next() }, "clock is before the ID epoch")
+	})
+
+	t.Run("invalid config", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewIDGenerator(1024)
+		AssertNotNil(t, err)
+		AssertContains(t, err.Error(), "node must be between 0 and 1023, got 1024")
+
+		_, err = NewIDGenerator(-1)
+		AssertNotNil(t, err)
+
+		_, err = NewIDGenerator(0, WithIDNodeBits(22))
+		AssertNotNil(t, err)
+		AssertContains(t, err.Error(), "node bits must be less than 22, got 22")
+	})
+}
+
+func TestID(t *testing.T) {
+	t.Run("string round trip", func(t *testing.T) {
+		t.Parallel()
+		for _, id := range []ID{0, 1, 123456789, 1<<63 - 1} {
+			parsed, err := ParseID(id.String())
+			AssertNil(t, err)
+			AssertEqual(t, parsed, id)
+		}
+	})
+
+	t.Run("invalid strings", func(t *testing.T) {
+		t.Parallel()
+		for _, s := range []string{"", "0OIl", "zzzzzzzzzzzzzz"} {
+			_, err := ParseID(s)
+			AssertNotNil(t, err, s)
+		}
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+		type order struct {
+			ID ID `json:"id"`
+		}
+		data, err := json.Marshal(order{ID: 123456789})
+		AssertNil(t, err)
+		AssertEqual(t, string(data), `{"id":"1111BukQL"}`)
+
+		var got order
+		AssertNil(t, json.Unmarshal(data, &got))
+		AssertEqual(t, got.ID, ID(123456789))
+	})
+}
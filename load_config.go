@@ -1,16 +1,20 @@
 package pocket
 
 import (
+	"encoding"
 	"fmt"
 	"os"
 	"reflect"
 	"strconv"
+	"strings"
+	"sync"
 	"time"
 )
 
 // LoadConfigFromEnv returns a config struct populated with environment variables.
 //
 // It uses the `env` struct tag to determine the environment variable name
+// (fields without it use their name in SCREAMING_SNAKE_CASE, e.g. LogLevel → LOG_LEVEL)
 // and the `default` tag to determine the default value if the environment variable is not set.
 // It casts the value to the type specified in the struct field.
 //
@@ -20,10 +24,35 @@ import (
 //			Port     int           `env:"PORT" default:"8080"`
 //		    LogLevel string        `env:"LOG_LEVEL"`
 //	       Timeout  time.Duration `env:"TIMEOUT" default:"10s"`
+//	       TZ       *time.Location `env:"TZ" default:"UTC"`
 //		  }
 //
 //		  config, err := pocket.LoadConfigFromEnv[AppConfig]()
 func LoadConfigFromEnv[T any]() (*T, error) {
+	return LoadConfig[T](EnvSource{})
+}
+
+// Source provides raw configuration values by key.
+// Implement it to load configuration from places other than the environment,
+// such as AWS SSM, Vault or Consul, while keeping the same struct tags.
+type Source interface {
+	// Lookup returns the value for the given key and whether it was found.
+	// A non-nil error means the source could not be queried, not that the key is missing.
+	Lookup(key string) (string, bool, error)
+}
+
+// EnvSource is a Source backed by environment variables.
+type EnvSource struct{}
+
+// Lookup returns the value of the environment variable named by the key.
+func (EnvSource) Lookup(key string) (string, bool, error) {
+	value, ok := os.LookupEnv(key)
+	return value, ok, nil
+}
+
+// LoadConfig returns a config struct populated with values from the given source.
+// It works like LoadConfigFromEnv, the `env` tag being the key looked up in the source.
+func LoadConfig[T any](src Source) (*T, error) {
 	config := new(T)
 
 	v := reflect.TypeOf(*config)
@@ -31,18 +60,24 @@ func LoadConfigFromEnv[T any]() (*T, error) {
 	for i := 0; i < v.NumField(); i++ {
 		structField := v.Field(i).Name
 		structFieldType := v.Field(i).Type
-		envVarName := v.Field(i).Tag.Get("env")
+		key := v.Field(i).Tag.Get("env")
+		if key == "" {
+			key = strings.ToUpper(ToSnakeCase(structField))
+		}
 		defaultValue := v.Field(i).Tag.Get("default")
 
-		envVarValue, ok := os.LookupEnv(envVarName)
+		rawValue, ok, err := src.Lookup(key)
+		if err != nil {
+			return nil, fmt.Errorf("cannot look up %v: %w", key, err)
+		}
 		if !ok {
 			if defaultValue == "" {
-				return nil, fmt.Errorf("missing env var %v (no default provided)", envVarName)
+				return nil, fmt.Errorf("missing value for %v (no default provided)", key)
 			}
-			envVarValue = defaultValue
+			rawValue = defaultValue
 		}
 
-		value, err := cast(structFieldType.Name(), envVarValue)
+		value, err := cast(structFieldType, rawValue)
 		if err != nil {
 			return nil, err
 		}
@@ -53,8 +88,86 @@ func LoadConfigFromEnv[T any]() (*T, error) {
 	return config, nil
 }
 
-func cast(fieldType string, fieldValue string) (reflect.Value, error) {
-	switch fieldType {
+// DefaultsOnly returns a config struct populated purely from `default` tags, without reading the environment.
+// Fields without a default keep their zero value.
+// Useful for tests and for generating example config files.
+func DefaultsOnly[T any]() (*T, error) {
+	config := new(T)
+
+	v := reflect.TypeOf(*config)
+
+	for i := 0; i < v.NumField(); i++ {
+		defaultValue := v.Field(i).Tag.Get("default")
+		if defaultValue == "" {
+			continue
+		}
+
+		value, err := cast(v.Field(i).Type, defaultValue)
+		if err != nil {
+			return nil, err
+		}
+
+		reflect.ValueOf(config).Elem().Field(i).Set(value)
+	}
+
+	return config, nil
+}
+
+var (
+	configParsersMu sync.RWMutex
+	configParsers   = map[reflect.Type]func(string) (reflect.Value, error){}
+)
+
+// RegisterConfigParser registers a parser for fields of type T,
+// so the config loader can handle application-specific types (enums, byte sizes, log levels, etc.).
+// Registered parsers take precedence over the built-in ones. Registering a type twice replaces its parser.
+//
+// Example:
+//
+//	pocket.RegisterConfigParser(func(s string) (slog.Level, error) {
+//		var level slog.Level
+//		err := level.UnmarshalText([]byte(s))
+//		return level, err
+//	})
+func RegisterConfigParser[T any](parse func(string) (T, error)) {
+	configParsersMu.Lock()
+	defer configParsersMu.Unlock()
+
+	configParsers[reflect.TypeFor[T]()] = func(s string) (reflect.Value, error) {
+		v, err := parse(s)
+		if err != nil {
+			return reflect.ValueOf(nil), err
+		}
+		return reflect.ValueOf(&v).Elem(), nil
+	}
+}
+
+var textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+
+func cast(fieldType reflect.Type, fieldValue string) (reflect.Value, error) {
+	configParsersMu.RLock()
+	parse, ok := configParsers[fieldType]
+	configParsersMu.RUnlock()
+	if ok {
+		v, err := parse(fieldValue)
+		if err != nil {
+			e := fmt.Errorf("cannot parse %s as %s: %w", fieldValue, fieldType, err)
+			return reflect.ValueOf(nil), e
+		}
+		return v, nil
+	}
+
+	// Types that know how to parse themselves (like Money) take precedence.
+	if reflect.PointerTo(fieldType).Implements(textUnmarshalerType) {
+		v := reflect.New(fieldType)
+		if err := v.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(fieldValue)); err != nil {
+			e := fmt.Errorf("cannot parse %s as %s: %w", fieldValue, fieldType, err)
+			return reflect.ValueOf(nil), e
+		}
+		return v.Elem(), nil
+	}
+
+	switch fieldType.String() {
 	case "string":
 		return reflect.ValueOf(fieldValue), nil
 	case "int":
@@ -71,14 +184,31 @@ func cast(fieldType string, fieldValue string) (reflect.Value, error) {
 			return reflect.ValueOf(nil), e
 		}
 		return reflect.ValueOf(v), nil
-	case "Duration":
+	case "time.Duration":
 		v, err := time.ParseDuration(fieldValue)
 		if err != nil {
 			e := fmt.Errorf("cannot parse %s as time.Duration: %w", fieldValue, err)
 			return reflect.ValueOf(nil), e
 		}
 		return reflect.ValueOf(v), nil
+	case "*time.Location":
+		v, err := time.LoadLocation(fieldValue)
+		if err != nil {
+			e := fmt.Errorf("cannot parse %s as *time.Location: %w", fieldValue, err)
+			return reflect.ValueOf(nil), e
+		}
+		return reflect.ValueOf(v), nil
 	default:
+		// Pointers to supported types, e.g. an optional *int, point to a freshly parsed value.
+		if fieldType.Kind() == reflect.Pointer {
+			elem, err := cast(fieldType.Elem(), fieldValue)
+			if err != nil {
+				return reflect.ValueOf(nil), err
+			}
+			ptr := reflect.New(fieldType.Elem())
+			ptr.Elem().Set(elem)
+			return ptr, nil
+		}
 		return reflect.ValueOf(nil), fmt.Errorf("unsupported type %s", fieldType)
 	}
 }
@@ -1,6 +1,8 @@
 package pocket
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"testing"
 	"time"
@@ -54,6 +56,34 @@ func TestLoadConfigFromEnv(t *testing.T) {
 		AssertEqual(t, myConfig.TimeoutM, 45*time.Minute)
 	})
 
+	t.Run("parses_locations", func(t *testing.T) {
+		cleanEnv()
+		os.Setenv("TZ", "America/Argentina/Buenos_Aires")
+		type MyConfig struct {
+			TZ       *time.Location `env:"TZ"`
+			Fallback *time.Location `env:"FALLBACK_TZ" default:"UTC"`
+		}
+
+		myConfig, err := LoadConfigFromEnv[MyConfig]()
+		AssertNil(t, err)
+		AssertEqual(t, myConfig.TZ.String(), "America/Argentina/Buenos_Aires")
+		AssertEqual(t, myConfig.Fallback, time.UTC)
+	})
+
+	t.Run("parses_money", func(t *testing.T) {
+		cleanEnv()
+		os.Setenv("BUDGET", "100.00 USD")
+		type MyConfig struct {
+			Budget Money `env:"BUDGET"`
+			Fee    Money `env:"FEE" default:"0.50000000 BTC"`
+		}
+
+		myConfig, err := LoadConfigFromEnv[MyConfig]()
+		AssertNil(t, err)
+		AssertTrue(t, myConfig.Budget.Equals(NewUSD(100_00)))
+		AssertTrue(t, myConfig.Fee.Equals(Must(NewMoney(50000000, "BTC", 8))))
+	})
+
 	t.Run("env_overrides_default", func(t *testing.T) {
 		cleanEnv()
 		os.Setenv("ENV", "production")
@@ -109,6 +139,177 @@ func TestLoadConfigFromEnv(t *testing.T) {
 		_, err := LoadConfigFromEnv[MyConfig]()
 		AssertNotNil(t, err)
 	})
+
+	t.Run("errors_on_wrong_type_money", func(t *testing.T) {
+		cleanEnv()
+		os.Setenv("BUDGET", "100 USD")
+		type MyConfig struct {
+			Budget Money `env:"BUDGET"`
+		}
+
+		_, err := LoadConfigFromEnv[MyConfig]()
+		AssertNotNil(t, err)
+	})
+
+	t.Run("errors_on_unknown_location", func(t *testing.T) {
+		cleanEnv()
+		os.Setenv("TZ", "Mars/Olympus_Mons")
+		type MyConfig struct {
+			TZ *time.Location `env:"TZ"`
+		}
+
+		_, err := LoadConfigFromEnv[MyConfig]()
+		AssertNotNil(t, err)
+	})
+}
+
+func TestDefaultsOnly(t *testing.T) {
+	t.Run("ignores_env", func(t *testing.T) {
+		cleanEnv()
+		os.Setenv("PORT", "9090")
+		type MyConfig struct {
+			Env     string        `env:"ENV" default:"dev"`
+			Port    int           `env:"PORT" default:"8080"`
+			Timeout time.Duration `env:"TIMEOUT" default:"5s"`
+		}
+
+		myConfig, err := DefaultsOnly[MyConfig]()
+		AssertNil(t, err)
+		AssertEqual(t, myConfig.Env, "dev")
+		AssertEqual(t, myConfig.Port, 8080)
+		AssertEqual(t, myConfig.Timeout, 5*time.Second)
+	})
+
+	t.Run("leaves_zero_value_without_default", func(t *testing.T) {
+		cleanEnv()
+		os.Setenv("LOG_LEVEL", "debug")
+		type MyConfig struct {
+			LogLevel string `env:"LOG_LEVEL"`
+			Port     int    `env:"PORT" default:"8080"`
+		}
+
+		myConfig, err := DefaultsOnly[MyConfig]()
+		AssertNil(t, err)
+		AssertEqual(t, myConfig.LogLevel, "")
+		AssertEqual(t, myConfig.Port, 8080)
+	})
+
+	t.Run("errors_on_invalid_default", func(t *testing.T) {
+		type MyConfig struct {
+			Port int `env:"PORT" default:"hello"`
+		}
+
+		_, err := DefaultsOnly[MyConfig]()
+		AssertNotNil(t, err)
+	})
+}
+
+type logLevel int
+
+const (
+	levelInfo logLevel = iota
+	levelDebug
+)
+
+func TestRegisterConfigParser(t *testing.T) {
+	RegisterConfigParser(func(s string) (logLevel, error) {
+		switch s {
+		case "info":
+			return levelInfo, nil
+		case "debug":
+			return levelDebug, nil
+		default:
+			return 0, fmt.Errorf("unknown log level %q", s)
+		}
+	})
+
+	t.Run("uses_registered_parser", func(t *testing.T) {
+		src := mapSource{"LOG_LEVEL": "debug"}
+		type MyConfig struct {
+			Level    logLevel `env:"LOG_LEVEL"`
+			Fallback logLevel `env:"FALLBACK_LEVEL" default:"info"`
+		}
+
+		myConfig, err := LoadConfig[MyConfig](src)
+		AssertNil(t, err)
+		AssertEqual(t, myConfig.Level, levelDebug)
+		AssertEqual(t, myConfig.Fallback, levelInfo)
+	})
+
+	t.Run("errors_on_parser_failure", func(t *testing.T) {
+		src := mapSource{"LOG_LEVEL": "verbose"}
+		type MyConfig struct {
+			Level logLevel `env:"LOG_LEVEL"`
+		}
+
+		_, err := LoadConfig[MyConfig](src)
+		AssertNotNil(t, err)
+		AssertContains(t, err.Error(), "unknown log level")
+	})
+}
+
+type mapSource map[string]string
+
+func (m mapSource) Lookup(key string) (string, bool, error) {
+	value, ok := m[key]
+	return value, ok, nil
+}
+
+type failingSource struct{}
+
+func (failingSource) Lookup(key string) (string, bool, error) {
+	return "", false, errors.New("connection refused")
+}
+
+func TestLoadConfig(t *testing.T) {
+	t.Run("from_source", func(t *testing.T) {
+		src := mapSource{"ENV": "staging", "PORT": "9090"}
+		type MyConfig struct {
+			Env   string `env:"ENV"`
+			Port  int    `env:"PORT" default:"8080"`
+			Debug bool   `env:"DEBUG" default:"true"`
+		}
+
+		myConfig, err := LoadConfig[MyConfig](src)
+		AssertNil(t, err)
+		AssertEqual(t, myConfig.Env, "staging")
+		AssertEqual(t, myConfig.Port, 9090)
+		AssertEqual(t, myConfig.Debug, true)
+	})
+
+	t.Run("derives_key_from_field_name", func(t *testing.T) {
+		src := mapSource{"LOG_LEVEL": "debug", "HTTP_PORT": "9090"}
+		type MyConfig struct {
+			LogLevel string
+			HTTPPort int
+			Env      string `default:"dev"`
+		}
+
+		myConfig, err := LoadConfig[MyConfig](src)
+		AssertNil(t, err)
+		AssertEqual(t, myConfig.LogLevel, "debug")
+		AssertEqual(t, myConfig.HTTPPort, 9090)
+		AssertEqual(t, myConfig.Env, "dev")
+	})
+
+	t.Run("errors_on_missing_value", func(t *testing.T) {
+		type MyConfig struct {
+			Env string `env:"ENV"`
+		}
+
+		_, err := LoadConfig[MyConfig](mapSource{})
+		AssertNotNil(t, err)
+	})
+
+	t.Run("errors_on_source_failure", func(t *testing.T) {
+		type MyConfig struct {
+			Env string `env:"ENV" default:"dev"`
+		}
+
+		_, err := LoadConfig[MyConfig](failingSource{})
+		AssertNotNil(t, err)
+		AssertContains(t, err.Error(), "connection refused")
+	})
 }
 
 // cleanEnv removes all env vars used for testing.
@@ -117,4 +318,7 @@ func cleanEnv() {
 	os.Unsetenv("ENV")
 	os.Unsetenv("PORT")
 	os.Unsetenv("TIMEOUT")
+	os.Unsetenv("TZ")
+	os.Unsetenv("BUDGET")
+	os.Unsetenv("LOG_LEVEL")
 }
@@ -0,0 +1,197 @@
+package pocket
+
+import (
+	"container/list"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// LRU is a size-bounded cache that evicts the least recently used entry when full.
+// Entries can also expire after a TTL. It is safe for concurrent use.
+type LRU[K comparable, V any] struct {
+	mu         sync.Mutex
+	capacity   int
+	entries    map[K]*list.Element
+	order      *list.List // front is the most recently used
+	onEvict    func(K, V)
+	clock      Clock
+	defaultTTL time.Duration
+}
+
+type lruEntry[K comparable, V any] struct {
+	key       K
+	value     V
+	expiresAt time.Time // zero means no expiry
+}
+
+// LRUOption configures an LRU.
+type LRUOption[K comparable, V any] func(*LRU[K, V])
+
+// WithEvictCallback sets a function called with every entry evicted for lack of space or because it expired.
+// It is not called for entries removed with Delete or replaced with Set.
+// The callback runs after the cache lock is released, so it may use the cache.
+func WithEvictCallback[K comparable, V any](f func(key K, value V)) LRUOption[K, V] {
+	return func(c *LRU[K, V]) {
+		c.onEvict = f
+	}
+}
+
+// WithDefaultTTL makes entries stored with Set expire after d. By default, they never expire.
+func WithDefaultTTL[K comparable, V any](d time.Duration) LRUOption[K, V] {
+	return func(c *LRU[K, V]) {
+		c.defaultTTL = d
+	}
+}
+
+// WithLRUClock sets the Clock used for expiry. Defaults to RealClock; use a FakeClock in tests.
+func WithLRUClock[K comparable, V any](clock Clock) LRUOption[K, V] {
+	return func(c *LRU[K, V]) {
+		c.clock = clock
+	}
+}
+
+// NewLRU returns an empty LRU holding at most capacity entries.
+// Panics if capacity is not positive.
+func NewLRU[K comparable, V any](capacity int, opts ...LRUOption[K, V]) *LRU[K, V] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("LRU capacity must be positive, got %d", capacity))
+	}
+
+	c := &LRU[K, V]{
+		capacity: capacity,
+		entries:  make(map[K]*list.Element, capacity),
+		order:    list.New(),
+		clock:    RealClock{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Get returns the value for the key and marks it as the most recently used.
+// The boolean is false if the key is missing or expired.
+func (c *LRU[K, V]) Get(key K) (V, bool) {
+	return c.get(key, true)
+}
+
+// Peek works like Get without marking the entry as recently used.
+func (c *LRU[K, V]) Peek(key K) (V, bool) {
+	return c.get(key, false)
+}
+
+func (c *LRU[K, V]) get(key K, touch bool) (V, bool) {
+	var zero V
+	var expired *lruEntry[K, V]
+
+	c.mu.Lock()
+	el, ok := c.entries[key]
+	if ok {
+		entry := el.Value.(*lruEntry[K, V])
+		if c.isExpired(entry) {
+			c.removeElement(el)
+			expired = entry
+		} else {
+			if touch {
+				c.order.MoveToFront(el)
+			}
+			value := entry.value
+			c.mu.Unlock()
+			return value, true
+		}
+	}
+	c.mu.Unlock()
+
+	if expired != nil {
+		c.notify([]*lruEntry[K, V]{expired})
+	}
+	return zero, false
+}
+
+// Set stores the value for the key, marking it as the most recently used
+// and evicting the least recently used entry if the cache is full.
+// The entry expires after the default TTL, if one was configured.
+func (c *LRU[K, V]) Set(key K, value V) {
+	c.SetWithTTL(key, value, c.defaultTTL)
+}
+
+// SetWithTTL works like Set, with the entry expiring after ttl. A non-positive ttl means it never expires.
+func (c *LRU[K, V]) SetWithTTL(key K, value V, ttl time.Duration) {
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = c.clock.Now().Add(ttl)
+	}
+
+	c.mu.Lock()
+	if el, ok := c.entries[key]; ok {
+		entry := el.Value.(*lruEntry[K, V])
+		entry.value, entry.expiresAt = value, expiresAt
+		c.order.MoveToFront(el)
+		c.mu.Unlock()
+		return
+	}
+
+	var evicted []*lruEntry[K, V]
+	for c.order.Len() >= c.capacity {
+		oldest := c.order.Back()
+		c.removeElement(oldest)
+		evicted = append(evicted, oldest.Value.(*lruEntry[K, V]))
+	}
+	c.entries[key] = c.order.PushFront(&lruEntry[K, V]{key: key, value: value, expiresAt: expiresAt})
+	c.mu.Unlock()
+
+	c.notify(evicted)
+}
+
+// Delete removes the key, reporting whether it was present (and not expired).
+func (c *LRU[K, V]) Delete(key K) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return false
+	}
+	c.removeElement(el)
+	return !c.isExpired(el.Value.(*lruEntry[K, V]))
+}
+
+// Len returns the number of entries, including expired ones that have not been removed yet.
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+// Keys returns the keys of the entries that have not expired, from the most to the least recently used.
+func (c *LRU[K, V]) Keys() []K {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	keys := make([]K, 0, c.order.Len())
+	for el := c.order.Front(); el != nil; el = el.Next() {
+		if entry := el.Value.(*lruEntry[K, V]); !c.isExpired(entry) {
+			keys = append(keys, entry.key)
+		}
+	}
+	return keys
+}
+
+func (c *LRU[K, V]) isExpired(entry *lruEntry[K, V]) bool {
+	return !entry.expiresAt.IsZero() && !c.clock.Now().Before(entry.expiresAt)
+}
+
+func (c *LRU[K, V]) removeElement(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.entries, el.Value.(*lruEntry[K, V]).key)
+}
+
+func (c *LRU[K, V]) notify(evicted []*lruEntry[K, V]) {
+	if c.onEvict == nil {
+		return
+	}
+	for _, entry := range evicted {
+		c.onEvict(entry.key, entry.value)
+	}
+}
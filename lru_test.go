@@ -0,0 +1,139 @@
+package pocket
+
+import (
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLRU(t *testing.T) {
+	t.Run("evicts the least recently used entry", func(t *testing.T) {
+		c := NewLRU[string, int](2)
+		c.Set("a", 1)
+		c.Set("b", 2)
+		c.Get("a")
+		c.Set("c", 3)
+
+		_, ok := c.Get("b")
+		AssertFalse(t, ok)
+		AssertEqual(t, c.Keys(), []string{"c", "a"})
+		AssertEqual(t, c.Len(), 2)
+	})
+
+	t.Run("peek does not change recency", func(t *testing.T) {
+		c := NewLRU[string, int](2)
+		c.Set("a", 1)
+		c.Set("b", 2)
+
+		v, ok := c.Peek("a")
+		AssertTrue(t, ok)
+		AssertEqual(t, v, 1)
+
+		c.Set("c", 3)
+		_, ok = c.Peek("a")
+		AssertFalse(t, ok)
+	})
+
+	t.Run("updating an entry marks it as recently used", func(t *testing.T) {
+		c := NewLRU[string, int](2)
+		c.Set("a", 1)
+		c.Set("b", 2)
+		c.Set("a", 10)
+		c.Set("c", 3)
+
+		v, ok := c.Get("a")
+		AssertTrue(t, ok)
+		AssertEqual(t, v, 10)
+		AssertEqual(t, c.Keys(), []string{"a", "c"})
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		c := NewLRU[string, int](2)
+		c.Set("a", 1)
+		AssertTrue(t, c.Delete("a"))
+		AssertFalse(t, c.Delete("a"))
+		AssertEqual(t, c.Len(), 0)
+	})
+
+	t.Run("calls the eviction callback", func(t *testing.T) {
+		var evicted []string
+		c := NewLRU(2, WithEvictCallback(func(k string, v int) {
+			evicted = append(evicted, k+"="+strconv.Itoa(v))
+		}))
+		c.Set("a", 1)
+		c.Set("b", 2)
+		c.Set("a", 10)
+		c.Delete("b")
+		c.Set("c", 3)
+		c.Set("d", 4)
+
+		AssertEqual(t, evicted, []string{"a=10"})
+	})
+
+	t.Run("panics on non-positive capacity", func(t *testing.T) {
+		AssertPanicsWith(t, func() { NewLRU[string, int](0) }, "LRU capacity must be positive, got 0")
+	})
+}
+
+func TestLRUTTL(t *testing.T) {
+	t.Run("entries expire", func(t *testing.T) {
+		clock := NewFakeClock(epoch)
+		var evicted []string
+		c := NewLRU(10,
+			WithLRUClock[string, int](clock),
+			WithEvictCallback(func(k string, _ int) { evicted = append(evicted, k) }),
+		)
+
+		c.SetWithTTL("short", 1, time.Minute)
+		c.SetWithTTL("long", 2, time.Hour)
+		c.Set("forever", 3)
+
+		clock.Advance(time.Minute)
+		_, ok := c.Get("short")
+		AssertFalse(t, ok)
+		AssertEqual(t, evicted, []string{"short"})
+		AssertEqual(t, c.Keys(), []string{"forever", "long"})
+
+		clock.Advance(time.Hour)
+		AssertEqual(t, c.Keys(), []string{"forever"})
+		AssertFalse(t, c.Delete("long"))
+
+		v, ok := c.Get("forever")
+		AssertTrue(t, ok)
+		AssertEqual(t, v, 3)
+	})
+
+	t.Run("default TTL", func(t *testing.T) {
+		clock := NewFakeClock(epoch)
+		c := NewLRU(10, WithLRUClock[string, int](clock), WithDefaultTTL[string, int](time.Second))
+
+		c.Set("a", 1)
+		c.SetWithTTL("b", 2, 0)
+		clock.Advance(time.Second)
+
+		_, ok := c.Peek("a")
+		AssertFalse(t, ok)
+		_, ok = c.Peek("b")
+		AssertTrue(t, ok)
+	})
+}
+
+func TestLRUConcurrency(t *testing.T) {
+	c := NewLRU(50, WithEvictCallback(func(string, int) {}))
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Go(func() {
+			for j := range 200 {
+				key := strconv.Itoa((i * j) % 80)
+				c.Set(key, j)
+				c.Get(key)
+				if j%10 == 0 {
+					c.Delete(key)
+				}
+			}
+		})
+	}
+	wg.Wait()
+	AssertLessOrEqual(t, c.Len(), 50)
+}
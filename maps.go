@@ -0,0 +1,247 @@
+package pocket
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// Keys returns the keys of the map as a slice, in random order.
+// Use SortedKeys when the order matters.
+func Keys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// Values returns the values of the map as a slice, in random order.
+// Use SortedValues to get them in the order of their keys.
+func Values[K comparable, V any](m map[K]V) []V {
+	values := make([]V, 0, len(m))
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return values
+}
+
+// Entries returns the key-value pairs of the map as a slice.
+// Map iteration order is random; use SortedEntries when the order matters.
+func Entries[K comparable, V any](m map[K]V) []Pair[K, V] {
+	entries := make([]Pair[K, V], 0, len(m))
+	for k, v := range m {
+		entries = append(entries, Pair[K, V]{First: k, Second: v})
+	}
+	return entries
+}
+
+// SortedKeys returns the keys of the map as a slice, in ascending order.
+func SortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := Keys(m)
+	slices.Sort(keys)
+	return keys
+}
+
+// IterSorted returns an iterator over the entries of the map in ascending order of their keys,
+// for deterministic iteration in tests, logs and generated docs.
+// The keys are sorted up front; entries deleted from the map while iterating are skipped.
+func IterSorted[K cmp.Ordered, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, k := range SortedKeys(m) {
+			v, ok := m[k]
+			if !ok {
+				continue
+			}
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// SortedValues returns the values of the map as a slice, in ascending order of their keys.
+func SortedValues[K cmp.Ordered, V any](m map[K]V) []V {
+	keys := SortedKeys(m)
+	values := make([]V, len(keys))
+	for i, k := range keys {
+		values[i] = m[k]
+	}
+	return values
+}
+
+// SortedEntries returns the key-value pairs of the map as a slice, in ascending order of their keys.
+func SortedEntries[K cmp.Ordered, V any](m map[K]V) []Pair[K, V] {
+	keys := SortedKeys(m)
+	entries := make([]Pair[K, V], len(keys))
+	for i, k := range keys {
+		entries[i] = Pair[K, V]{First: k, Second: m[k]}
+	}
+	return entries
+}
+
+// MapValues returns a new map with the same keys and the values transformed by the given function.
+func MapValues[K comparable, V any, W any](m map[K]V, f func(V) W) map[K]W {
+	result := make(map[K]W, len(m))
+	for k, v := range m {
+		result[k] = f(v)
+	}
+	return result
+}
+
+// MapKeys returns a new map with the same values and the keys transformed by the given function.
+// If f maps several keys to the same one, which of their values is kept is unspecified;
+// use MapKeysMerge to combine them, or MapKeysUnique to treat that as an error.
+func MapKeys[K comparable, V any, L comparable](m map[K]V, f func(K) L) map[L]V {
+	return MapKeysMerge(m, f, func(_, v V) V { return v })
+}
+
+// MapKeysMerge works like MapKeys, calling merge to combine the values of keys that collide.
+// Since map iteration order is random, merge should not depend on the order of its arguments (e.g. summing).
+func MapKeysMerge[K comparable, V any, L comparable](m map[K]V, f func(K) L, merge func(existing, v V) V) map[L]V {
+	result := make(map[L]V, len(m))
+	for k, v := range m {
+		l := f(k)
+		if existing, ok := result[l]; ok {
+			v = merge(existing, v)
+		}
+		result[l] = v
+	}
+	return result
+}
+
+// MapKeysUnique works like MapKeys, but returns an error if f maps several keys to the same one.
+func MapKeysUnique[K comparable, V any, L comparable](m map[K]V, f func(K) L) (map[L]V, error) {
+	result := make(map[L]V, len(m))
+	for k, v := range m {
+		l := f(k)
+		if _, dup := result[l]; dup {
+			return nil, fmt.Errorf("duplicate key %v (from %v)", l, k)
+		}
+		result[l] = v
+	}
+	return result, nil
+}
+
+// FilterEntries returns a new map with the entries for which the given function returns true.
+func FilterEntries[K comparable, V any](m map[K]V, f func(K, V) bool) map[K]V {
+	result := make(map[K]V)
+	for k, v := range m {
+		if f(k, v) {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// Pick returns a new map with only the given keys. Keys missing from m are ignored.
+func Pick[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	result := make(map[K]V, len(keys))
+	for _, k := range keys {
+		if v, ok := m[k]; ok {
+			result[k] = v
+		}
+	}
+	return result
+}
+
+// Omit returns a new map without the given keys.
+func Omit[K comparable, V any](m map[K]V, keys ...K) map[K]V {
+	exclude := toSet(keys)
+	return FilterEntries(m, func(k K, _ V) bool {
+		_, found := exclude[k]
+		return !found
+	})
+}
+
+// Invert returns a new map with keys and values swapped.
+// If several keys share a value, which of them is kept is unspecified; use InvertUnique to treat that as an error.
+func Invert[K comparable, V comparable](m map[K]V) map[V]K {
+	result := make(map[V]K, len(m))
+	for k, v := range m {
+		result[v] = k
+	}
+	return result
+}
+
+// InvertUnique works like Invert, but returns an error if several keys share a value.
+func InvertUnique[K comparable, V comparable](m map[K]V) (map[V]K, error) {
+	result := make(map[V]K, len(m))
+	for k, v := range m {
+		if _, dup := result[v]; dup {
+			return nil, fmt.Errorf("duplicate value %v", v)
+		}
+		result[v] = k
+	}
+	return result, nil
+}
+
+// GetOr returns the value for the key, or fallback if the key is not present.
+func GetOr[K comparable, V any](m map[K]V, key K, fallback V) V {
+	if v, ok := m[key]; ok {
+		return v
+	}
+	return fallback
+}
+
+// GetOrCompute returns the value for the key. If the key is not present,
+// it calls compute, stores the result in the map, and returns it.
+// Panics if the map is nil and the key is missing, like any write to a nil map.
+func GetOrCompute[K comparable, V any](m map[K]V, key K, compute func() V) V {
+	if v, ok := m[key]; ok {
+		return v
+	}
+	v := compute()
+	m[key] = v
+	return v
+}
+
+// DefaultMap is a map that creates missing values on access, for counters and grouped accumulation.
+// It is not safe for concurrent use.
+type DefaultMap[K comparable, V any] struct {
+	m        map[K]V
+	defaultV func() V
+}
+
+// NewDefaultMap returns an empty DefaultMap that calls newValue to create missing values.
+func NewDefaultMap[K comparable, V any](newValue func() V) *DefaultMap[K, V] {
+	return &DefaultMap[K, V]{m: make(map[K]V), defaultV: newValue}
+}
+
+// Get returns the value for the key, storing a new default value first if the key is not present.
+func (d *DefaultMap[K, V]) Get(key K) V {
+	return GetOrCompute(d.m, key, d.defaultV)
+}
+
+// Update replaces the value for the key with the result of f, which receives the current value
+// (or a new default value if the key is not present).
+func (d *DefaultMap[K, V]) Update(key K, f func(V) V) {
+	d.m[key] = f(d.Get(key))
+}
+
+// Set stores the value for the key.
+func (d *DefaultMap[K, V]) Set(key K, value V) {
+	d.m[key] = value
+}
+
+// Has reports whether the key is present, without creating it.
+func (d *DefaultMap[K, V]) Has(key K) bool {
+	_, ok := d.m[key]
+	return ok
+}
+
+// Delete removes the key.
+func (d *DefaultMap[K, V]) Delete(key K) {
+	delete(d.m, key)
+}
+
+// Len returns the number of entries.
+func (d *DefaultMap[K, V]) Len() int {
+	return len(d.m)
+}
+
+// Map returns the underlying map. Changes to it are reflected in the DefaultMap.
+func (d *DefaultMap[K, V]) Map() map[K]V {
+	return d.m
+}
@@ -0,0 +1,203 @@
+package pocket
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestKeys(t *testing.T) {
+	m := map[string]int{"b": 2, "a": 1, "c": 3}
+	AssertElementsMatch(t, Keys(m), []string{"a", "b", "c"})
+	AssertEqual(t, SortedKeys(m), []string{"a", "b", "c"})
+	AssertEqual(t, len(Keys(map[string]int{})), 0)
+	AssertEqual(t, len(SortedKeys(map[string]int(nil))), 0)
+}
+
+func TestIterSorted(t *testing.T) {
+	m := map[string]int{"b": 2, "c": 3, "a": 1}
+
+	var keys []string
+	var values []int
+	for k, v := range IterSorted(m) {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	AssertEqual(t, keys, []string{"a", "b", "c"})
+	AssertEqual(t, values, []int{1, 2, 3})
+
+	t.Run("stops early", func(t *testing.T) {
+		var keys []string
+		for k := range IterSorted(m) {
+			keys = append(keys, k)
+			break
+		}
+		AssertEqual(t, keys, []string{"a"})
+	})
+
+	t.Run("skips deleted entries", func(t *testing.T) {
+		m := map[int]bool{1: true, 2: true, 3: true}
+		var keys []int
+		for k := range IterSorted(m) {
+			keys = append(keys, k)
+			delete(m, 2)
+		}
+		AssertEqual(t, keys, []int{1, 3})
+	})
+}
+
+func TestValues(t *testing.T) {
+	m := map[string]int{"b": 1, "a": 2, "c": 3}
+	AssertElementsMatch(t, Values(m), []int{1, 2, 3})
+	AssertEqual(t, SortedValues(m), []int{2, 1, 3})
+	AssertEqual(t, len(Values(map[string]int{})), 0)
+}
+
+func TestEntries(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+
+	entries := Entries(m)
+	AssertElementsMatch(t, entries, []Pair[string, int]{{"a", 1}, {"b", 2}, {"c", 3}})
+
+	SortBy(entries, func(p Pair[string, int]) string { return p.First })
+	AssertEqual(t, entries, []Pair[string, int]{{"a", 1}, {"b", 2}, {"c", 3}})
+
+	AssertEqual(t, Associate(entries), m)
+	AssertEqual(t, len(Entries(map[string]int{})), 0)
+}
+
+func TestSortedEntries(t *testing.T) {
+	m := map[int]string{3: "c", 1: "a", 2: "b"}
+	AssertEqual(t, SortedEntries(m), []Pair[int, string]{{1, "a"}, {2, "b"}, {3, "c"}})
+	AssertEqual(t, len(SortedEntries(map[int]string{})), 0)
+}
+
+func TestMapValues(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	AssertEqual(t, MapValues(m, strconv.Itoa), map[string]string{"a": "1", "b": "2"})
+	AssertEqual(t, MapValues(map[string]int{}, strconv.Itoa), map[string]string{})
+}
+
+func TestMapKeys(t *testing.T) {
+	t.Run("transforms keys", func(t *testing.T) {
+		m := map[string]int{"a": 1, "b": 2}
+		AssertEqual(t, MapKeys(m, strings.ToUpper), map[string]int{"A": 1, "B": 2})
+	})
+
+	t.Run("keeps one value on collision", func(t *testing.T) {
+		got := MapKeys(map[string]int{"a": 1, "A": 2}, strings.ToUpper)
+		AssertEqual(t, len(got), 1)
+		AssertSliceContains(t, []int{1, 2}, got["A"])
+	})
+
+	t.Run("merges on collision", func(t *testing.T) {
+		m := map[string]int{"a": 1, "A": 2, "b": 3}
+		sum := func(a, b int) int { return a + b }
+		AssertEqual(t, MapKeysMerge(m, strings.ToUpper, sum), map[string]int{"A": 3, "B": 3})
+	})
+
+	t.Run("unique", func(t *testing.T) {
+		got, err := MapKeysUnique(map[string]int{"a": 1, "b": 2}, strings.ToUpper)
+		AssertNil(t, err)
+		AssertEqual(t, got, map[string]int{"A": 1, "B": 2})
+
+		got, err = MapKeysUnique(map[string]int{"a": 1, "A": 2}, strings.ToUpper)
+		AssertNil(t, got)
+		AssertNotNil(t, err)
+		AssertContains(t, err.Error(), "duplicate key A")
+	})
+}
+
+func TestFilterEntries(t *testing.T) {
+	m := map[string]string{"user": "ana", "token": "", "region": "eu"}
+	notEmpty := func(_ string, v string) bool { return v != "" }
+
+	AssertEqual(t, FilterEntries(m, notEmpty), map[string]string{"user": "ana", "region": "eu"})
+	AssertEqual(t, FilterEntries(map[string]string{}, notEmpty), map[string]string{})
+}
+
+func TestPick(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	AssertEqual(t, Pick(m, "a", "c", "z"), map[string]int{"a": 1, "c": 3})
+	AssertEqual(t, Pick(m), map[string]int{})
+}
+
+func TestOmit(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2, "c": 3}
+	AssertEqual(t, Omit(m, "a", "z"), map[string]int{"b": 2, "c": 3})
+	AssertEqual(t, Omit(m), m)
+}
+
+func TestInvert(t *testing.T) {
+	codes := map[string]int{"ok": 200, "not_found": 404}
+	AssertEqual(t, Invert(codes), map[int]string{200: "ok", 404: "not_found"})
+
+	got, err := InvertUnique(codes)
+	AssertNil(t, err)
+	AssertEqual(t, got, map[int]string{200: "ok", 404: "not_found"})
+
+	aliases := map[string]int{"ok": 200, "success": 200}
+	AssertEqual(t, len(Invert(aliases)), 1)
+
+	got, err = InvertUnique(aliases)
+	AssertNil(t, got)
+	AssertNotNil(t, err)
+	AssertEqual(t, err.Error(), "duplicate value 200")
+}
+
+func TestGetOr(t *testing.T) {
+	m := map[string]int{"a": 1, "zero": 0}
+	AssertEqual(t, GetOr(m, "a", 9), 1)
+	AssertEqual(t, GetOr(m, "zero", 9), 0)
+	AssertEqual(t, GetOr(m, "z", 9), 9)
+	AssertEqual(t, GetOr(map[string]int(nil), "z", 9), 9)
+}
+
+func TestGetOrCompute(t *testing.T) {
+	m := map[string][]int{}
+	calls := 0
+	compute := func() []int {
+		calls++
+		return []int{}
+	}
+
+	m["a"] = append(GetOrCompute(m, "a", compute), 1)
+	m["a"] = append(GetOrCompute(m, "a", compute), 2)
+	AssertEqual(t, m["a"], []int{1, 2})
+	AssertEqual(t, calls, 1)
+}
+
+func TestDefaultMap(t *testing.T) {
+	t.Run("counters", func(t *testing.T) {
+		counts := NewDefaultMap[string](func() int { return 0 })
+		for _, word := range []string{"a", "b", "a"} {
+			counts.Update(word, func(n int) int { return n + 1 })
+		}
+		AssertEqual(t, counts.Map(), map[string]int{"a": 2, "b": 1})
+	})
+
+	t.Run("grouping", func(t *testing.T) {
+		groups := NewDefaultMap[int](func() *[]string { return &[]string{} })
+		for _, word := range []string{"go", "rust", "c", "zig"} {
+			g := groups.Get(len(word))
+			*g = append(*g, word)
+		}
+		AssertEqual(t, *groups.Get(2), []string{"go"})
+		AssertEqual(t, *groups.Get(3), []string{"zig"})
+		AssertEqual(t, groups.Len(), 4)
+	})
+
+	t.Run("get materializes defaults", func(t *testing.T) {
+		m := NewDefaultMap[string](func() int { return 7 })
+		AssertFalse(t, m.Has("a"))
+		AssertEqual(t, m.Get("a"), 7)
+		AssertTrue(t, m.Has("a"))
+
+		m.Set("b", 1)
+		AssertEqual(t, m.Get("b"), 1)
+
+		m.Delete("a")
+		AssertFalse(t, m.Has("a"))
+		AssertEqual(t, m.Len(), 1)
+	})
+}
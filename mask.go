@@ -0,0 +1,86 @@
+package pocket
+
+import "strings"
+
+// MaskString replaces the characters of s with '*', leaving visiblePrefix runes at the start
+// and visibleSuffix runes at the end untouched.
+// If the visible parts would reveal the whole string, the whole string is masked.
+func MaskString(s string, visiblePrefix, visibleSuffix int) string {
+	runes := []rune(s)
+	visiblePrefix = max(visiblePrefix, 0)
+	visibleSuffix = max(visibleSuffix, 0)
+
+	if visiblePrefix+visibleSuffix >= len(runes) {
+		return strings.Repeat("*", len(runes))
+	}
+
+	masked := len(runes) - visiblePrefix - visibleSuffix
+	return string(runes[:visiblePrefix]) + strings.Repeat("*", masked) + string(runes[len(runes)-visibleSuffix:])
+}
+
+// MaskEmail masks the local part of an email address, keeping its first character and the domain,
+// e.g. "john.doe@example.com" → "j*******@example.com".
+// Values that don't look like an email address are fully masked.
+func MaskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 || at == len(email)-1 {
+		return MaskString(email, 0, 0)
+	}
+	return MaskString(email[:at], 1, 0) + email[at:]
+}
+
+// MaskPAN masks a card number (Primary Account Number), keeping only the last 4 digits
+// and any spaces or dashes, e.g. "4242 4242 4242 4242" → "**** **** **** 4242".
+// Values that are not a valid card number (12 to 19 digits passing the Luhn check) are fully masked,
+// so that a mistyped number never leaks more than a valid one would.
+func MaskPAN(pan string) string {
+	digits := 0
+	for _, r := range pan {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case r == ' ' || r == '-':
+		default:
+			return MaskString(pan, 0, 0)
+		}
+	}
+
+	if digits < 12 || digits > 19 || !luhnValid(pan) {
+		return MaskString(pan, 0, 0)
+	}
+
+	var sb strings.Builder
+	seen := 0
+	for _, r := range pan {
+		if r >= '0' && r <= '9' {
+			seen++
+			if seen <= digits-4 {
+				r = '*'
+			}
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// luhnValid reports whether the digits in s pass the Luhn checksum. Non-digit characters are ignored.
+func luhnValid(s string) bool {
+	sum := 0
+	double := false
+	for i := len(s) - 1; i >= 0; i-- {
+		c := s[i]
+		if c < '0' || c > '9' {
+			continue
+		}
+		d := int(c - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
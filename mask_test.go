@@ -0,0 +1,72 @@
+package pocket
+
+import "testing"
+
+func TestMaskString(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		prefix int
+		suffix int
+		want   string
+	}{
+		{name: "prefix and suffix", input: "secret-token", prefix: 2, suffix: 3, want: "se*******ken"},
+		{name: "suffix only", input: "123456789", prefix: 0, suffix: 4, want: "*****6789"},
+		{name: "prefix only", input: "abcdef", prefix: 1, suffix: 0, want: "a*****"},
+		{name: "nothing visible", input: "abc", prefix: 0, suffix: 0, want: "***"},
+		{name: "would reveal everything", input: "abcd", prefix: 2, suffix: 2, want: "****"},
+		{name: "negative counts", input: "abcd", prefix: -1, suffix: -1, want: "****"},
+		{name: "multi-byte", input: "contraseña", prefix: 1, suffix: 2, want: "c*******ña"},
+		{name: "empty", input: "", prefix: 1, suffix: 1, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, MaskString(tt.input, tt.prefix, tt.suffix), tt.want)
+		})
+	}
+}
+
+func TestMaskEmail(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "john.doe@example.com", want: "j*******@example.com"},
+		{input: "a@example.com", want: "*@example.com"},
+		{input: "weird@local@example.com", want: "w**********@example.com"},
+		{input: "not-an-email", want: "************"},
+		{input: "@example.com", want: "************"},
+		{input: "john@", want: "*****"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, MaskEmail(tt.input), tt.want)
+		})
+	}
+}
+
+func TestMaskPAN(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "4242424242424242", want: "************4242"},
+		{input: "4242 4242 4242 4242", want: "**** **** **** 4242"},
+		{input: "5555-5555-5555-4444", want: "****-****-****-4444"},
+		{input: "378282246310005", want: "***********0005"},
+		{input: "4242424242424241", want: "****************"},
+		{input: "12345", want: "*****"},
+		{input: "4242x4242", want: "*********"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, MaskPAN(tt.input), tt.want)
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package pocket
+
+import (
+	"context"
+	"time"
+)
+
+const memoizeDefaultMaxEntries = 1000
+
+type memoizeConfig struct {
+	ttl        time.Duration
+	maxEntries int
+	clock      Clock
+}
+
+// MemoizeOption configures Memoize.
+type MemoizeOption func(*memoizeConfig)
+
+// WithMemoizeTTL makes cached results expire after d. By default, they never expire.
+func WithMemoizeTTL(d time.Duration) MemoizeOption {
+	return func(c *memoizeConfig) {
+		c.ttl = d
+	}
+}
+
+// WithMemoizeMaxEntries bounds the number of cached results, evicting the least recently used ones.
+// Defaults to 1000.
+func WithMemoizeMaxEntries(n int) MemoizeOption {
+	return func(c *memoizeConfig) {
+		c.maxEntries = n
+	}
+}
+
+// WithMemoizeClock sets the Clock used for expiry. Defaults to RealClock; use a FakeClock in tests.
+func WithMemoizeClock(clock Clock) MemoizeOption {
+	return func(c *memoizeConfig) {
+		c.clock = clock
+	}
+}
+
+// Memoize returns a version of fn that caches its results by key in an LRU.
+// Concurrent calls for a key that is not cached yet share a single call to fn, as with Dedup.
+// Errors are not cached, so the next call for the key tries again.
+// The returned function is safe for concurrent use. Panics if the max entries are not positive.
+//
+// Example:
+//
+//	getUser := pocket.Memoize(repo.GetUser, pocket.WithMemoizeTTL(time.Minute))
+//	user, err := getUser(ctx, id)
+func Memoize[K comparable, V any](fn func(ctx context.Context, key K) (V, error), opts ...MemoizeOption) func(ctx context.Context, key K) (V, error) {
+	cfg := memoizeConfig{maxEntries: memoizeDefaultMaxEntries, clock: RealClock{}}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	cache := NewLRU(cfg.maxEntries, WithDefaultTTL[K, V](cfg.ttl), WithLRUClock[K, V](cfg.clock))
+	var dedup Dedup[K, V]
+
+	return func(ctx context.Context, key K) (V, error) {
+		if v, ok := cache.Get(key); ok {
+			return v, nil
+		}
+
+		v, err, _ := dedup.Do(ctx, key, func(ctx context.Context) (V, error) {
+			// Another call may have filled the cache between our miss and this call starting.
+			if v, ok := cache.Peek(key); ok {
+				return v, nil
+			}
+			v, err := fn(ctx, key)
+			if err == nil {
+				cache.Set(key, v)
+			}
+			return v, err
+		})
+		return v, err
+	}
+}
@@ -0,0 +1,119 @@
+package pocket
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMemoize(t *testing.T) {
+	t.Run("caches results by key", func(t *testing.T) {
+		t.Parallel()
+		calls := map[int]int{}
+		square := Memoize(func(_ context.Context, n int) (int, error) {
+			calls[n]++
+			return n * n, nil
+		})
+
+		for range 3 {
+			v, err := square(context.Background(), 4)
+			AssertNil(t, err)
+			AssertEqual(t, v, 16)
+		}
+		v, err := square(context.Background(), 5)
+		AssertNil(t, err)
+		AssertEqual(t, v, 25)
+		AssertEqual(t, calls, map[int]int{4: 1, 5: 1})
+	})
+
+	t.Run("does not cache errors", func(t *testing.T) {
+		t.Parallel()
+		errDown := errors.New("down")
+		calls := 0
+		get := Memoize(func(context.Context, string) (string, error) {
+			calls++
+			if calls == 1 {
+				return "", errDown
+			}
+			return "ok", nil
+		})
+
+		_, err := get(context.Background(), "k")
+		AssertErrorIs(t, err, errDown)
+		v, err := get(context.Background(), "k")
+		AssertNil(t, err)
+		AssertEqual(t, v, "ok")
+		AssertEqual(t, calls, 2)
+	})
+
+	t.Run("expires after ttl", func(t *testing.T) {
+		t.Parallel()
+		clock := NewFakeClock(epoch)
+		calls := 0
+		get := Memoize(func(context.Context, string) (int, error) {
+			calls++
+			return calls, nil
+		}, WithMemoizeTTL(time.Minute), WithMemoizeClock(clock))
+
+		v, _ := get(context.Background(), "k")
+		AssertEqual(t, v, 1)
+		clock.Advance(59 * time.Second)
+		v, _ = get(context.Background(), "k")
+		AssertEqual(t, v, 1)
+		clock.Advance(time.Second)
+		v, _ = get(context.Background(), "k")
+		AssertEqual(t, v, 2)
+	})
+
+	t.Run("evicts beyond max entries", func(t *testing.T) {
+		t.Parallel()
+		calls := 0
+		get := Memoize(func(_ context.Context, n int) (int, error) {
+			calls++
+			return n, nil
+		}, WithMemoizeMaxEntries(2))
+
+		for _, n := range []int{1, 2, 3, 1} {
+			_, err := get(context.Background(), n)
+			AssertNil(t, err)
+		}
+		AssertEqual(t, calls, 4)
+	})
+
+	t.Run("concurrent misses share one call", func(t *testing.T) {
+		t.Parallel()
+		var calls atomic.Int32
+		release := make(chan struct{})
+		get := Memoize(func(context.Context, string) (string, error) {
+			calls.Add(1)
+			<-release
+			return "value", nil
+		})
+
+		var wg sync.WaitGroup
+		results := make([]string, 10)
+		for i := range results {
+			wg.Go(func() {
+				results[i], _ = get(context.Background(), "k")
+			})
+		}
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		AssertEqual(t, calls.Load(), int32(1))
+		for _, r := range results {
+			AssertEqual(t, r, "value")
+		}
+	})
+
+	t.Run("invalid max entries panics", func(t *testing.T) {
+		t.Parallel()
+		AssertPanics(t, func() {
+			Memoize(func(context.Context, int) (int, error) { return 0, nil }, WithMemoizeMaxEntries(0))
+		})
+	})
+}
@@ -1,6 +1,7 @@
 package pocket
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"math"
@@ -103,6 +104,115 @@ func NewMoneyFromString(s string) (Money, error) {
 	return NewMoney(total, currency, precision)
 }
 
+// moneySymbols are the currency symbols ParseMoney ignores.
+const moneySymbols = "$€£¥"
+
+// ParseMoney leniently parses a human-entered amount, such as "1,234.56", "$100.99", "-$5" or "1.234,56 EUR",
+// into a Money with the given currency and precision.
+//
+// Currency symbols and spaces are ignored, and a currency code, if present, must match currency.
+// When both '.' and ',' appear, the last one is the decimal separator and the other separates thousands.
+// When only one of them appears, it is the decimal separator unless it appears more than once,
+// or it is followed by exactly three digits, in which case it separates thousands (so "1,234" is 1234, but "1,5" is 1.5).
+// A single separator followed by three digits is still the decimal separator when the precision is 3 or more,
+// or when there is nothing but zeros before it, so "1.500 BTC" is 1.5 and "0.125 KWD" is 0.125.
+// Missing decimals are padded with zeros; more decimals than the precision are an error rather than silently rounded.
+// Use NewMoneyFromString for strict, machine-generated input.
+func ParseMoney(s string, currency string, precision int) (Money, error) {
+	if precision < 0 || precision > 8 {
+		return Money{}, fmt.Errorf("precision must be between 0 and 8, got %d", precision)
+	}
+	currency = strings.ToUpper(currency)
+
+	var number strings.Builder
+	var code strings.Builder
+	negative := false
+	for _, r := range s {
+		switch {
+		case r >= '0' && r <= '9', r == '.', r == ',':
+			number.WriteRune(r)
+		case r == '-' && number.Len() == 0 && !negative:
+			negative = true
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+			code.WriteRune(r)
+		case r == ' ' || r == '\u00a0' || r == '_' || strings.ContainsRune(moneySymbols, r):
+		default:
+			return Money{}, fmt.Errorf("invalid amount %q: unexpected character %q", s, r)
+		}
+	}
+	if c := strings.ToUpper(code.String()); c != "" && c != currency {
+		return Money{}, fmt.Errorf("invalid amount %q: expected currency %s", s, currency)
+	}
+
+	whole, frac, err := splitLenientAmount(number.String(), precision)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount %q: %w", s, err)
+	}
+	if len(frac) > precision {
+		return Money{}, fmt.Errorf("invalid amount %q: more than %d decimal places", s, precision)
+	}
+	frac += strings.Repeat("0", precision-len(frac))
+
+	amount, err := strconv.ParseInt(whole+frac, 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("invalid amount %q: %w", s, err)
+	}
+	if negative {
+		amount = -amount
+	}
+	return NewMoney(amount, currency, precision)
+}
+
+// splitLenientAmount splits digits with '.' and ',' separators into whole and fractional digits.
+// The precision decides whether a lone separator followed by three digits is a decimal separator.
+func splitLenientAmount(number string, precision int) (whole, frac string, err error) {
+	if number == "" || strings.Trim(number, ".,") == "" {
+		return "", "", errors.New("no digits")
+	}
+
+	decimal := rune(0)
+	lastDot, lastComma := strings.LastIndexByte(number, '.'), strings.LastIndexByte(number, ',')
+	switch {
+	case lastDot >= 0 && lastComma >= 0:
+		decimal = '.'
+		if lastComma > lastDot {
+			decimal = ','
+		}
+	case lastDot >= 0 || lastComma >= 0:
+		sep := byte('.')
+		last := lastDot
+		if lastComma >= 0 {
+			sep, last = ',', lastComma
+		}
+		if strings.Count(number, string(sep)) == 1 {
+			thousands := len(number)-last-1 == 3 && precision < 3 && strings.Trim(number[:last], "0") != ""
+			if !thousands {
+				decimal = rune(sep)
+			}
+		}
+	}
+
+	if decimal != 0 {
+		i := strings.LastIndexByte(number, byte(decimal))
+		whole, frac = number[:i], number[i+1:]
+		if strings.ContainsRune(whole, decimal) {
+			return "", "", fmt.Errorf("more than one decimal separator %q", decimal)
+		}
+	} else {
+		whole = number
+	}
+	if strings.ContainsAny(frac, ".,") {
+		return "", "", errors.New("separator after the decimal separator")
+	}
+
+	// Whatever remains in the whole part separates thousands.
+	whole = strings.NewReplacer(".", "", ",", "").Replace(whole)
+	if whole == "" {
+		whole = "0"
+	}
+	return whole, frac, nil
+}
+
 // Returns the currency of the money.
 func (m Money) Currency() string {
 	return m.currency
@@ -166,6 +276,21 @@ func (m Money) Format() string {
 	return fmt.Sprintf("%s %s", m.String(), m.currency)
 }
 
+// MarshalText implements encoding.TextMarshaler using the "amount currency" format.
+func (m Money) MarshalText() ([]byte, error) {
+	return []byte(m.Format()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, parsing "amount currency" with NewMoneyFromString.
+func (m *Money) UnmarshalText(text []byte) error {
+	parsed, err := NewMoneyFromString(string(text))
+	if err != nil {
+		return err
+	}
+	*m = parsed
+	return nil
+}
+
 // Plus returns a new Money with the sum of the two amounts.
 // Returns an error if the currencies don't match or if overflow occurs.
 func (m Money) Plus(other Money) (Money, error) {
@@ -246,45 +371,244 @@ func (m Money) Times(amount int64) (Money, error) {
 	return NewMoney(prod, m.currency, m.precision)
 }
 
+// RoundingMode selects how operations that divide money round results to the smallest unit.
+type RoundingMode int
+
+const (
+	// RoundHalfUp rounds to the nearest unit, with halves rounded away from zero. It is the default.
+	RoundHalfUp RoundingMode = iota
+	// RoundHalfEven rounds to the nearest unit, with halves rounded to the even neighbor (banker's rounding).
+	RoundHalfEven
+	// RoundFloor rounds towards negative infinity.
+	RoundFloor
+	// RoundCeil rounds towards positive infinity.
+	RoundCeil
+	// RoundTruncate rounds towards zero, dropping the remainder.
+	RoundTruncate
+)
+
+// String returns the name of the rounding mode, e.g. "half-even".
+func (r RoundingMode) String() string {
+	switch r {
+	case RoundHalfUp:
+		return "half-up"
+	case RoundHalfEven:
+		return "half-even"
+	case RoundFloor:
+		return "floor"
+	case RoundCeil:
+		return "ceil"
+	case RoundTruncate:
+		return "truncate"
+	default:
+		return fmt.Sprintf("RoundingMode(%d)", int(r))
+	}
+}
+
+// roundingMode returns the optional rounding mode passed to an operation, defaulting to RoundHalfUp.
+func roundingMode(modes []RoundingMode) RoundingMode {
+	if len(modes) == 0 {
+		return RoundHalfUp
+	}
+	return modes[0]
+}
+
+// divRound returns a / b rounded with the given mode.
+func divRound(a, b int64, mode RoundingMode) (int64, error) {
+	quotient, err := TrySafeDiv(a, b)
+	if err != nil {
+		return 0, err
+	}
+	remainder := a % b
+	if remainder == 0 {
+		return quotient, nil
+	}
+
+	// The exact result lies between quotient and quotient+direction.
+	direction := int64(1)
+	if (a < 0) != (b < 0) {
+		direction = -1
+	}
+
+	absRemainder, absDivisor := remainder, b
+	if absRemainder < 0 {
+		absRemainder = -absRemainder
+	}
+	if absDivisor < 0 {
+		absDivisor = -absDivisor
+	}
+	// Compare the remainder with half the divisor without overflowing: 2r vs d is r vs d-r.
+	half := cmp.Compare(absRemainder, absDivisor-absRemainder)
+
+	awayFromZero, err := roundsAwayFromZero(mode, half, quotient%2 != 0, direction < 0)
+	if err != nil {
+		return 0, err
+	}
+	if awayFromZero {
+		quotient += direction
+	}
+	return quotient, nil
+}
+
+// roundsAwayFromZero reports whether an inexact quotient must move one unit away from zero under the given mode.
+// half compares the remainder with half the divisor, odd tells whether the truncated quotient is odd,
+// and negative whether the exact result is negative.
+func roundsAwayFromZero(mode RoundingMode, half int, odd, negative bool) (bool, error) {
+	switch mode {
+	case RoundHalfUp:
+		return half >= 0, nil
+	case RoundHalfEven:
+		return half > 0 || (half == 0 && odd), nil
+	case RoundFloor:
+		return negative, nil
+	case RoundCeil:
+		return !negative, nil
+	case RoundTruncate:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unknown rounding mode %v", mode)
+	}
+}
+
 // DividedBy returns a new Money instance with the amount divided by the given divisor.
-// Uses half-up rounding: fractions >= 0.5 round up, < 0.5 round down.
-func (m Money) DividedBy(divisor int64) (Money, error) {
+// The result is rounded with the given mode, half-up by default: fractions >= 0.5 round away from zero, < 0.5 towards it.
+func (m Money) DividedBy(divisor int64, mode ...RoundingMode) (Money, error) {
 	if !m.initialized {
 		return Money{}, errors.New("Money instances must be created with the constructor")
 	}
 
-	quotient, err := TrySafeDiv(m.amount, divisor)
+	quotient, err := divRound(m.amount, divisor, roundingMode(mode))
 	if err != nil {
-		return Money{}, fmt.Errorf("cannot multiply amounts: %w", err)
+		return Money{}, fmt.Errorf("cannot divide amounts: %w", err)
 	}
 
-	remainder := m.amount % divisor
+	return NewMoney(quotient, m.currency, m.precision)
+}
 
-	// For half-up rounding, we need to check if abs(remainder) >= abs(divisor)/2
-	// Handle both positive and negative cases
-	absReminder := remainder
-	if absReminder < 0 {
-		absReminder = -absReminder
+// Percent returns a new Money with the given percentage of the amount, e.g. 15 for a 15% tip.
+// The result is rounded with the given mode, half-up by default.
+// Returns an error if the intermediate product overflows.
+func (m Money) Percent(percent int64, mode ...RoundingMode) (Money, error) {
+	if !m.initialized {
+		return Money{}, errors.New("Money instances must be created with the constructor")
 	}
-	absDivisor := divisor
-	if absDivisor < 0 {
-		absDivisor = -absDivisor
+
+	prod, err := TrySafeMul(m.amount, percent)
+	if err != nil {
+		return Money{}, fmt.Errorf("cannot compute percentage: %w", err)
 	}
+	result, err := divRound(prod, 100, roundingMode(mode))
+	if err != nil {
+		return Money{}, fmt.Errorf("cannot compute percentage: %w", err)
+	}
+
+	return NewMoney(result, m.currency, m.precision)
+}
+
+// IsZero returns true if the amount is zero.
+func (m Money) IsZero() bool {
+	return m.amount == 0
+}
+
+// IsPositive returns true if the amount is greater than zero.
+func (m Money) IsPositive() bool {
+	return m.amount > 0
+}
+
+// IsNegative returns true if the amount is less than zero.
+func (m Money) IsNegative() bool {
+	return m.amount < 0
+}
 
-	// Check if we should round up
-	// remainder * 2 >= divisor (avoiding division for precision)
-	if absReminder*2 >= absDivisor {
-		if (m.amount >= 0 && divisor > 0) || (m.amount < 0 && divisor < 0) {
-			quotient++
-		} else {
-			quotient--
+// Abs returns a new Money with the absolute value of the amount.
+// Returns an error if the amount is the minimum int64, whose absolute value overflows.
+func (m Money) Abs() (Money, error) {
+	if m.amount >= 0 {
+		if !m.initialized {
+			return Money{}, errors.New("Money instances must be created with the constructor")
 		}
+		return m, nil
 	}
+	return m.Negate()
+}
 
-	return NewMoney(quotient, m.currency, m.precision)
+// Negate returns a new Money with the sign of the amount flipped.
+// Returns an error if the amount is the minimum int64, whose negation overflows.
+func (m Money) Negate() (Money, error) {
+	if !m.initialized {
+		return Money{}, errors.New("Money instances must be created with the constructor")
+	}
+
+	neg, err := TrySafeSub(0, m.amount)
+	if err != nil {
+		return Money{}, fmt.Errorf("cannot negate amount: %w", err)
+	}
+
+	return NewMoney(neg, m.currency, m.precision)
+}
+
+// Split divides the money into n parts that always sum to the original amount.
+// The remainder is spread one smallest unit at a time over the first parts,
+// e.g. splitting 100.00 USD in 3 gives 33.34, 33.33 and 33.33.
+func (m Money) Split(n int) ([]Money, error) {
+	if !m.initialized {
+		return nil, errors.New("Money instances must be created with the constructor")
+	}
+	if n <= 0 {
+		return nil, fmt.Errorf("cannot split into %d parts: n must be positive", n)
+	}
+
+	share := m.amount / int64(n)
+	remainder := m.amount % int64(n)
+	unit := int64(1)
+	if remainder < 0 {
+		remainder, unit = -remainder, -1
+	}
+
+	parts := make([]Money, n)
+	for i := range parts {
+		amount := share
+		if int64(i) < remainder {
+			amount += unit
+		}
+		parts[i] = Money{amount: amount, currency: m.currency, precision: m.precision, initialized: true}
+	}
+	return parts, nil
+}
+
+// Rescale returns the money converted to the given precision, e.g. a 4-decimal internal amount
+// to a 2-decimal display amount. Lowering the precision rounds with the given mode; raising it is exact.
+// Returns an error if the precision is out of range or overflow occurs.
+func (m Money) Rescale(precision int, mode RoundingMode) (Money, error) {
+	if !m.initialized {
+		return Money{}, errors.New("Money instances must be created with the constructor")
+	}
+	if precision < 0 || precision > 8 {
+		return Money{}, fmt.Errorf("precision must be between 0 and 8, got %d", precision)
+	}
+
+	diff := precision - m.precision
+	factor := int64(1)
+	for range max(diff, -diff) {
+		factor *= 10
+	}
+
+	amount := m.amount
+	var err error
+	if diff > 0 {
+		amount, err = TrySafeMul(m.amount, factor)
+	} else if diff < 0 {
+		amount, err = divRound(m.amount, factor, mode)
+	}
+	if err != nil {
+		return Money{}, fmt.Errorf("cannot rescale amount: %w", err)
+	}
+
+	return NewMoney(amount, m.currency, precision)
 }
 
 // Equals returns true if the two moneys have the same amount, currency, and precision.
+// Use Rescale to compare amounts held at different precisions.
 func (m Money) Equals(other Money) bool {
 	return m.amount == other.Amount() && m.currency == other.Currency() && m.precision == other.Precision()
 }
@@ -394,6 +394,244 @@ func TestMoney_DividedBy(t *testing.T) {
 	}
 }
 
+func TestMoney_DividedByRoundingModes(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount int64
+		div    int64
+		mode   RoundingMode
+		want   int64
+	}{
+		{"half-up rounds half away from zero", 25, 10, RoundHalfUp, 3},
+		{"half-up rounds negative half away from zero", -25, 10, RoundHalfUp, -3},
+		{"half-even rounds half to even down", 25, 10, RoundHalfEven, 2},
+		{"half-even rounds half to even up", 35, 10, RoundHalfEven, 4},
+		{"half-even rounds negative half to even", -25, 10, RoundHalfEven, -2},
+		{"half-even rounds above half up", 26, 10, RoundHalfEven, 3},
+		{"floor rounds positive down", 29, 10, RoundFloor, 2},
+		{"floor rounds negative down", -21, 10, RoundFloor, -3},
+		{"ceil rounds positive up", 21, 10, RoundCeil, 3},
+		{"ceil rounds negative up", -29, 10, RoundCeil, -2},
+		{"truncate rounds towards zero", 29, 10, RoundTruncate, 2},
+		{"truncate rounds negative towards zero", -29, 10, RoundTruncate, -2},
+		{"negative divisor", 25, -10, RoundFloor, -3},
+		{"exact division ignores mode", 30, 10, RoundCeil, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result, err := NewUSD(tt.amount).DividedBy(tt.div, tt.mode)
+			AssertNil(t, err)
+			AssertEqual(t, result.Amount(), tt.want)
+		})
+	}
+
+	t.Run("unknown mode returns error", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewUSD(25).DividedBy(10, RoundingMode(42))
+		AssertNotNil(t, err)
+	})
+
+	t.Run("min int divided by -1 returns error", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewUSD(math.MinInt64).DividedBy(-1)
+		AssertNotNil(t, err)
+	})
+}
+
+func TestMoney_Percent(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  int64
+		percent int64
+		mode    []RoundingMode
+		want    int64
+		wantErr bool
+	}{
+		{name: "whole percentage", amount: 200_00, percent: 15, want: 30_00},
+		{name: "defaults to half-up", amount: 1_50, percent: 1, want: 2},
+		{name: "half-even", amount: 1_50, percent: 1, mode: []RoundingMode{RoundHalfEven}, want: 2},
+		{name: "half-even rounds to even", amount: 2_50, percent: 1, mode: []RoundingMode{RoundHalfEven}, want: 2},
+		{name: "floor", amount: 1_99, percent: 1, mode: []RoundingMode{RoundFloor}, want: 1},
+		{name: "negative amount", amount: -200_00, percent: 15, want: -30_00},
+		{name: "over a hundred percent", amount: 10_00, percent: 250, want: 25_00},
+		{name: "overflow returns error", amount: math.MaxInt64, percent: 2, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result, err := NewUSD(tt.amount).Percent(tt.percent, tt.mode...)
+			if tt.wantErr {
+				AssertNotNil(t, err)
+				return
+			}
+			AssertNil(t, err)
+			AssertEqual(t, result.Amount(), tt.want)
+			AssertEqual(t, result.Currency(), "USD")
+		})
+	}
+}
+
+func TestMoney_Rescale(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    int64
+		precision int
+		target    int
+		mode      RoundingMode
+		want      int64
+		wantErr   bool
+	}{
+		{name: "lower precision rounds half-up", amount: 12_3450, precision: 4, target: 2, mode: RoundHalfUp, want: 12_35},
+		{name: "lower precision rounds half-even", amount: 12_3450, precision: 4, target: 2, mode: RoundHalfEven, want: 12_34},
+		{name: "lower precision truncates", amount: 12_3499, precision: 4, target: 2, mode: RoundTruncate, want: 12_34},
+		{name: "negative amount", amount: -12_3450, precision: 4, target: 2, mode: RoundHalfUp, want: -12_35},
+		{name: "raise precision", amount: 12_34, precision: 2, target: 4, want: 12_3400},
+		{name: "same precision", amount: 12_34, precision: 2, target: 2, want: 12_34},
+		{name: "to zero precision", amount: 12_50, precision: 2, target: 0, mode: RoundCeil, want: 13},
+		{name: "precision out of range", amount: 12_34, precision: 2, target: 9, wantErr: true},
+		{name: "negative precision", amount: 12_34, precision: 2, target: -1, wantErr: true},
+		{name: "overflow", amount: math.MaxInt64, precision: 0, target: 8, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			m, _ := NewMoney(tt.amount, "USD", tt.precision)
+			result, err := m.Rescale(tt.target, tt.mode)
+			if tt.wantErr {
+				AssertNotNil(t, err)
+				return
+			}
+			AssertNil(t, err)
+			AssertEqual(t, result.Amount(), tt.want)
+			AssertEqual(t, result.Precision(), tt.target)
+			AssertEqual(t, result.Currency(), "USD")
+		})
+	}
+
+	t.Run("normalizes for Equals", func(t *testing.T) {
+		t.Parallel()
+		internal, _ := NewMoney(12_3400, "USD", 4)
+		AssertFalse(t, internal.Equals(NewUSD(12_34)))
+		display, err := internal.Rescale(2, RoundHalfEven)
+		AssertNil(t, err)
+		AssertTrue(t, display.Equals(NewUSD(12_34)))
+	})
+}
+
+func TestRoundingMode_String(t *testing.T) {
+	AssertEqual(t, RoundHalfEven.String(), "half-even")
+	AssertEqual(t, RoundingMode(42).String(), "RoundingMode(42)")
+}
+
+func TestMoney_Sign(t *testing.T) {
+	tests := []struct {
+		name         string
+		m            Money
+		zero         bool
+		positive     bool
+		negative     bool
+		abs, negated string
+	}{
+		{name: "positive", m: NewUSD(10_50), positive: true, abs: "10.50 USD", negated: "-10.50 USD"},
+		{name: "negative", m: NewUSD(-10_50), negative: true, abs: "10.50 USD", negated: "10.50 USD"},
+		{name: "zero", m: NewUSD(0), zero: true, abs: "0.00 USD", negated: "0.00 USD"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			AssertEqual(t, tt.m.IsZero(), tt.zero)
+			AssertEqual(t, tt.m.IsPositive(), tt.positive)
+			AssertEqual(t, tt.m.IsNegative(), tt.negative)
+
+			abs, err := tt.m.Abs()
+			AssertNil(t, err)
+			AssertEqual(t, abs.Format(), tt.abs)
+
+			negated, err := tt.m.Negate()
+			AssertNil(t, err)
+			AssertEqual(t, negated.Format(), tt.negated)
+		})
+	}
+
+	t.Run("overflow", func(t *testing.T) {
+		m := Must(NewMoney(math.MinInt64, "USD", 2))
+		_, err := m.Abs()
+		AssertNotNil(t, err)
+		_, err = m.Negate()
+		AssertNotNil(t, err)
+	})
+
+	t.Run("uninitialized", func(t *testing.T) {
+		_, err := Money{}.Abs()
+		AssertNotNil(t, err)
+		_, err = Money{}.Negate()
+		AssertNotNil(t, err)
+	})
+}
+
+func TestMoney_Split(t *testing.T) {
+	tests := []struct {
+		name string
+		m    Money
+		n    int
+		want []string
+	}{
+		{
+			name: "even split",
+			m:    NewUSD(90_00),
+			n:    3,
+			want: []string{"30.00 USD", "30.00 USD", "30.00 USD"},
+		},
+		{
+			name: "remainder goes to the first parts",
+			m:    NewUSD(100_00),
+			n:    3,
+			want: []string{"33.34 USD", "33.33 USD", "33.33 USD"},
+		},
+		{
+			name: "negative amount",
+			m:    NewUSD(-100_00),
+			n:    3,
+			want: []string{"-33.34 USD", "-33.33 USD", "-33.33 USD"},
+		},
+		{
+			name: "more parts than units",
+			m:    Must(NewMoney(2, "BTC", 0)),
+			n:    4,
+			want: []string{"1 BTC", "1 BTC", "0 BTC", "0 BTC"},
+		},
+		{
+			name: "single part",
+			m:    NewUSD(100_00),
+			n:    1,
+			want: []string{"100.00 USD"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parts, err := tt.m.Split(tt.n)
+			AssertNil(t, err)
+			AssertEqual(t, Map(parts, Money.Format), tt.want)
+
+			var sum int64
+			for _, p := range parts {
+				sum += p.Amount()
+			}
+			AssertEqual(t, sum, tt.m.Amount())
+		})
+	}
+
+	t.Run("invalid n", func(t *testing.T) {
+		_, err := NewUSD(100_00).Split(0)
+		AssertNotNil(t, err)
+	})
+
+	t.Run("uninitialized", func(t *testing.T) {
+		_, err := Money{}.Split(2)
+		AssertNotNil(t, err)
+	})
+}
+
 func TestMoney_Equals(t *testing.T) {
 	tests := []struct {
 		name string
@@ -606,3 +844,80 @@ func TestNewMoneyFromString(t *testing.T) {
 		})
 	}
 }
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		currency  string
+		precision int
+		want      int64
+		wantErr   bool
+	}{
+		{name: "plain", input: "100.99", currency: "USD", precision: 2, want: 10_099},
+		{name: "symbol", input: "$100.99", currency: "USD", precision: 2, want: 10_099},
+		{name: "thousands separators", input: "1,234.56 USD", currency: "USD", precision: 2, want: 123_456},
+		{name: "several thousands separators", input: "$1,234,567", currency: "USD", precision: 2, want: 123_456_700},
+		{name: "decimal comma", input: "1.234,56 €", currency: "EUR", precision: 2, want: 123_456},
+		{name: "single decimal comma", input: "1,5", currency: "EUR", precision: 2, want: 150},
+		{name: "comma before three digits separates thousands", input: "1,234", currency: "USD", precision: 2, want: 123_400},
+		{name: "no decimals", input: "100", currency: "ARS", precision: 2, want: 10_000},
+		{name: "leading currency code", input: "usd 42.5", currency: "USD", precision: 2, want: 4250},
+		{name: "negative", input: "-$5.25", currency: "USD", precision: 2, want: -525},
+		{name: "negative after symbol", input: "$-5.25", currency: "USD", precision: 2, want: -525},
+		{name: "leading decimal point", input: ".5", currency: "USD", precision: 2, want: 50},
+		{name: "spaces and underscores", input: " 1 000_000.00 ", currency: "USD", precision: 2, want: 100_000_000},
+		{name: "zero precision", input: "¥1,500", currency: "JPY", precision: 0, want: 1500},
+		{name: "crypto precision", input: "0.00012345 BTC", currency: "BTC", precision: 8, want: 12_345},
+		{name: "three decimals with three-digit precision", input: "0.125 KWD", currency: "KWD", precision: 3, want: 125},
+		{name: "three-digit precision", input: "12.500 KWD", currency: "KWD", precision: 3, want: 12_500},
+		{name: "three decimals with crypto precision", input: "1.500 BTC", currency: "BTC", precision: 8, want: 150_000_000},
+		{name: "zero before three digits is a decimal point", input: "0,125", currency: "EUR", precision: 3, want: 125},
+		{name: "zero whole part", input: "0.125", currency: "USD", precision: 2, wantErr: true},
+		{name: "leading separator before three digits", input: ".125", currency: "BTC", precision: 8, want: 12_500_000},
+		{name: "thousands with three-digit precision", input: "1,234.567 KWD", currency: "KWD", precision: 3, want: 1_234_567},
+		{name: "too many decimals", input: "1.9999", currency: "USD", precision: 2, wantErr: true},
+		{name: "currency mismatch", input: "100 EUR", currency: "USD", precision: 2, wantErr: true},
+		{name: "no digits", input: "$", currency: "USD", precision: 2, wantErr: true},
+		{name: "empty", input: "", currency: "USD", precision: 2, wantErr: true},
+		{name: "unexpected character", input: "12#34", currency: "USD", precision: 2, wantErr: true},
+		{name: "misplaced minus", input: "5-", currency: "USD", precision: 2, wantErr: true},
+		{name: "two decimal separators", input: "1.000.50,00.1", currency: "USD", precision: 2, wantErr: true},
+		{name: "overflow", input: "99,999,999,999,999,999.00", currency: "USD", precision: 2, wantErr: true},
+		{name: "invalid precision", input: "1", currency: "USD", precision: 9, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMoney(tt.input, tt.currency, tt.precision)
+			if tt.wantErr {
+				AssertNotNil(t, err)
+				return
+			}
+			AssertNil(t, err)
+			AssertEqual(t, got.Amount(), tt.want)
+			AssertEqual(t, got.Currency(), tt.currency)
+			AssertEqual(t, got.Precision(), tt.precision)
+		})
+	}
+}
+
+func TestMoney_TextMarshaling(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		text, err := NewUSD(100_99).MarshalText()
+		AssertNil(t, err)
+		AssertEqual(t, string(text), "100.99 USD")
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var m Money
+		err := m.UnmarshalText([]byte("100.99 usd"))
+		AssertNil(t, err)
+		AssertTrue(t, m.Equals(NewUSD(100_99)))
+	})
+
+	t.Run("unmarshal invalid", func(t *testing.T) {
+		var m Money
+		err := m.UnmarshalText([]byte("100 USD"))
+		AssertNotNil(t, err)
+	})
+}
@@ -0,0 +1,82 @@
+package pocket
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding/json"
+)
+
+// Null holds a value that may be missing, mapping to NULL in databases and null in JSON.
+// It replaces sql.NullString, sql.NullInt64 and friends with a single type that also works with encoding/json.
+// The zero value is invalid (null).
+type Null[T any] struct {
+	V     T
+	Valid bool
+}
+
+// NewNull returns a valid Null holding v.
+func NewNull[T any](v T) Null[T] {
+	return Null[T]{V: v, Valid: true}
+}
+
+// NullFromPtr returns a Null holding *p, or an invalid Null if p is nil.
+func NullFromPtr[T any](p *T) Null[T] {
+	if p == nil {
+		return Null[T]{}
+	}
+	return NewNull(*p)
+}
+
+// Ptr returns a pointer to a copy of the value, or nil if n is invalid.
+func (n Null[T]) Ptr() *T {
+	if !n.Valid {
+		return nil
+	}
+	return Ptr(n.V)
+}
+
+// ValueOr returns the value, or fallback if n is invalid.
+func (n Null[T]) ValueOr(fallback T) T {
+	if !n.Valid {
+		return fallback
+	}
+	return n.V
+}
+
+// Scan implements sql.Scanner, with the same conversions as sql.Null.
+func (n *Null[T]) Scan(src any) error {
+	var s sql.Null[T]
+	if err := s.Scan(src); err != nil {
+		return err
+	}
+	n.V, n.Valid = s.V, s.Valid
+	return nil
+}
+
+// Value implements driver.Valuer, returning nil for an invalid Null.
+func (n Null[T]) Value() (driver.Value, error) {
+	return sql.Null[T]{V: n.V, Valid: n.Valid}.Value()
+}
+
+// MarshalJSON implements json.Marshaler, encoding an invalid Null as null and a valid one as its value.
+func (n Null[T]) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.V)
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding null as an invalid Null.
+func (n *Null[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		*n = Null[T]{}
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*n = NewNull(v)
+	return nil
+}
@@ -0,0 +1,76 @@
+package pocket
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNull(t *testing.T) {
+	t.Run("constructors and accessors", func(t *testing.T) {
+		t.Parallel()
+		n := NewNull(42)
+		AssertTrue(t, n.Valid)
+		AssertEqual(t, n.ValueOr(0), 42)
+		AssertEqual(t, *n.Ptr(), 42)
+
+		var invalid Null[int]
+		AssertFalse(t, invalid.Valid)
+		AssertEqual(t, invalid.ValueOr(7), 7)
+		AssertNil(t, invalid.Ptr())
+
+		AssertEqual(t, NullFromPtr(Ptr("x")), NewNull("x"))
+		AssertEqual(t, NullFromPtr[string](nil), Null[string]{})
+	})
+
+	t.Run("scan", func(t *testing.T) {
+		t.Parallel()
+		var s Null[string]
+		AssertNil(t, s.Scan("hello"))
+		AssertEqual(t, s, NewNull("hello"))
+		AssertNil(t, s.Scan(nil))
+		AssertEqual(t, s, Null[string]{})
+
+		var i Null[int64]
+		AssertNil(t, i.Scan(int64(7)))
+		AssertEqual(t, i, NewNull(int64(7)))
+
+		var ts Null[time.Time]
+		AssertNil(t, ts.Scan(epoch))
+		AssertEqual(t, ts, NewNull(epoch))
+
+		AssertNotNil(t, i.Scan("not a number"))
+	})
+
+	t.Run("value", func(t *testing.T) {
+		t.Parallel()
+		v, err := NewNull("hello").Value()
+		AssertNil(t, err)
+		AssertEqual(t, v, driver.Value("hello"))
+
+		v, err = Null[string]{}.Value()
+		AssertNil(t, err)
+		AssertNil(t, v)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+		type user struct {
+			Name     string         `json:"name"`
+			Nickname Null[string]   `json:"nickname"`
+			Age      Null[int]      `json:"age"`
+			Tags     Null[[]string] `json:"tags"`
+		}
+
+		data, err := json.Marshal(user{Name: "Ana", Age: NewNull(30)})
+		AssertNil(t, err)
+		AssertEqual(t, string(data), `{"name":"Ana","nickname":null,"age":30,"tags":null}`)
+
+		var got user
+		AssertNil(t, json.Unmarshal([]byte(`{"name":"Bo","nickname":"bobby","age":null,"tags":["a"]}`), &got))
+		AssertEqual(t, got, user{Name: "Bo", Nickname: NewNull("bobby"), Tags: NewNull([]string{"a"})})
+
+		AssertNotNil(t, json.Unmarshal([]byte(`{"age":"thirty"}`), &got))
+	})
+}
@@ -0,0 +1,215 @@
+package pocket
+
+import (
+	"bytes"
+	"container/list"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"reflect"
+	"strconv"
+)
+
+// OrderedMap is a map that remembers the order in which keys were first inserted.
+// Iteration and JSON encoding follow that order, which makes config dumps and API responses deterministic.
+// The zero value is an empty map ready to use. It is not safe for concurrent use.
+type OrderedMap[K comparable, V any] struct {
+	entries map[K]*list.Element
+	order   *list.List
+}
+
+// NewOrderedMap returns an empty OrderedMap.
+func NewOrderedMap[K comparable, V any]() *OrderedMap[K, V] {
+	return &OrderedMap[K, V]{}
+}
+
+func (m *OrderedMap[K, V]) init() {
+	if m.entries == nil {
+		m.entries = make(map[K]*list.Element)
+		m.order = list.New()
+	}
+}
+
+// Set stores the value for the key. Updating an existing key keeps its original position.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	m.init()
+	if el, ok := m.entries[key]; ok {
+		el.Value.(*Pair[K, V]).Second = value
+		return
+	}
+	m.entries[key] = m.order.PushBack(&Pair[K, V]{First: key, Second: value})
+}
+
+// Get returns the value for the key, and whether it was present.
+func (m *OrderedMap[K, V]) Get(key K) (V, bool) {
+	if el, ok := m.entries[key]; ok {
+		return el.Value.(*Pair[K, V]).Second, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Has reports whether the key is present.
+func (m *OrderedMap[K, V]) Has(key K) bool {
+	_, ok := m.entries[key]
+	return ok
+}
+
+// Delete removes the key, reporting whether it was present.
+func (m *OrderedMap[K, V]) Delete(key K) bool {
+	el, ok := m.entries[key]
+	if !ok {
+		return false
+	}
+	m.order.Remove(el)
+	delete(m.entries, key)
+	return true
+}
+
+// Len returns the number of entries.
+func (m *OrderedMap[K, V]) Len() int {
+	return len(m.entries)
+}
+
+// Keys returns the keys in insertion order.
+func (m *OrderedMap[K, V]) Keys() []K {
+	keys := make([]K, 0, m.Len())
+	for k := range m.All() {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+// All returns an iterator over the entries in insertion order.
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m.order == nil {
+			return
+		}
+		for el := m.order.Front(); el != nil; el = el.Next() {
+			p := el.Value.(*Pair[K, V])
+			if !yield(p.First, p.Second) {
+				return
+			}
+		}
+	}
+}
+
+// MarshalJSON encodes the map as a JSON object with its keys in insertion order.
+// Keys follow the same rules as encoding/json map keys: strings, integers, or encoding.TextMarshaler.
+// It has a value receiver so that an OrderedMap held by value, e.g. in a struct field, is encoded too.
+func (m OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+
+	first := true
+	for k, v := range m.All() {
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+
+		name, err := jsonKeyString(k)
+		if err != nil {
+			return nil, err
+		}
+		keyJSON, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		valueJSON, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("cannot marshal value for key %q: %w", name, err)
+		}
+		buf.Write(keyJSON)
+		buf.WriteByte(':')
+		buf.Write(valueJSON)
+	}
+
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON object, keeping its keys in the order they appear.
+// Entries are added to any already in the map.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("cannot unmarshal %v into OrderedMap: expected object", tok)
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+
+		var key K
+		if err := parseJSONKey(tok.(string), &key); err != nil {
+			return err
+		}
+
+		var value V
+		if err := dec.Decode(&value); err != nil {
+			return fmt.Errorf("cannot unmarshal value for key %q: %w", tok, err)
+		}
+		m.Set(key, value)
+	}
+
+	_, err = dec.Token()
+	return err
+}
+
+// jsonKeyString converts a map key to its JSON object key, like encoding/json does.
+func jsonKeyString(key any) (string, error) {
+	if tm, ok := key.(encoding.TextMarshaler); ok {
+		text, err := tm.MarshalText()
+		return string(text), err
+	}
+
+	v := reflect.ValueOf(key)
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	default:
+		return "", fmt.Errorf("unsupported key type %T", key)
+	}
+}
+
+// parseJSONKey parses a JSON object key into the key pointed to by dst, reversing jsonKeyString.
+func parseJSONKey(s string, dst any) error {
+	if tu, ok := dst.(encoding.TextUnmarshaler); ok {
+		return tu.UnmarshalText([]byte(s))
+	}
+
+	v := reflect.ValueOf(dst).Elem()
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("cannot parse key %q as %s: %w", s, v.Type(), err)
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("cannot parse key %q as %s: %w", s, v.Type(), err)
+		}
+		v.SetUint(n)
+	default:
+		return fmt.Errorf("unsupported key type %s", v.Type())
+	}
+	return nil
+}
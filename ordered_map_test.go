@@ -0,0 +1,173 @@
+package pocket
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestOrderedMap(t *testing.T) {
+	t.Run("keeps insertion order", func(t *testing.T) {
+		m := NewOrderedMap[string, int]()
+		m.Set("c", 3)
+		m.Set("a", 1)
+		m.Set("b", 2)
+		AssertEqual(t, m.Keys(), []string{"c", "a", "b"})
+		AssertEqual(t, m.Len(), 3)
+	})
+
+	t.Run("updating keeps position", func(t *testing.T) {
+		m := NewOrderedMap[string, int]()
+		m.Set("a", 1)
+		m.Set("b", 2)
+		m.Set("a", 10)
+		AssertEqual(t, m.Keys(), []string{"a", "b"})
+
+		v, ok := m.Get("a")
+		AssertTrue(t, ok)
+		AssertEqual(t, v, 10)
+	})
+
+	t.Run("get and has", func(t *testing.T) {
+		m := NewOrderedMap[string, int]()
+		m.Set("a", 1)
+
+		_, ok := m.Get("z")
+		AssertFalse(t, ok)
+		AssertTrue(t, m.Has("a"))
+		AssertFalse(t, m.Has("z"))
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		m := NewOrderedMap[string, int]()
+		m.Set("a", 1)
+		m.Set("b", 2)
+		m.Set("c", 3)
+
+		AssertTrue(t, m.Delete("b"))
+		AssertFalse(t, m.Delete("b"))
+		AssertEqual(t, m.Keys(), []string{"a", "c"})
+
+		m.Set("b", 4)
+		AssertEqual(t, m.Keys(), []string{"a", "c", "b"})
+	})
+
+	t.Run("iterates in order and stops early", func(t *testing.T) {
+		m := NewOrderedMap[int, string]()
+		m.Set(2, "two")
+		m.Set(1, "one")
+		m.Set(3, "three")
+
+		var values []string
+		for _, v := range m.All() {
+			values = append(values, v)
+			if len(values) == 2 {
+				break
+			}
+		}
+		AssertEqual(t, values, []string{"two", "one"})
+	})
+
+	t.Run("zero value is usable", func(t *testing.T) {
+		var m OrderedMap[string, int]
+		AssertEqual(t, m.Len(), 0)
+		AssertEqual(t, len(m.Keys()), 0)
+		_, ok := m.Get("a")
+		AssertFalse(t, ok)
+		AssertFalse(t, m.Delete("a"))
+
+		m.Set("a", 1)
+		AssertEqual(t, m.Keys(), []string{"a"})
+	})
+}
+
+func TestOrderedMapJSON(t *testing.T) {
+	t.Run("marshals in insertion order", func(t *testing.T) {
+		m := NewOrderedMap[string, any]()
+		m.Set("zeta", 1)
+		m.Set("alpha", []int{1, 2})
+		m.Set("mid", map[string]bool{"x": true})
+
+		data, err := json.Marshal(m)
+		AssertNil(t, err)
+		AssertEqual(t, string(data), `{"zeta":1,"alpha":[1,2],"mid":{"x":true}}`)
+	})
+
+	t.Run("marshals a map held by value", func(t *testing.T) {
+		type wrap struct {
+			M OrderedMap[string, int]
+		}
+		var w wrap
+		w.M.Set("b", 2)
+		w.M.Set("a", 1)
+
+		data, err := json.Marshal(w)
+		AssertNil(t, err)
+		AssertEqual(t, string(data), `{"M":{"b":2,"a":1}}`)
+
+		data, err = json.Marshal(&w)
+		AssertNil(t, err)
+		AssertEqual(t, string(data), `{"M":{"b":2,"a":1}}`)
+	})
+
+	t.Run("marshals a nil map as null", func(t *testing.T) {
+		var m *OrderedMap[string, int]
+		data, err := json.Marshal(m)
+		AssertNil(t, err)
+		AssertEqual(t, string(data), `null`)
+	})
+
+	t.Run("marshals empty map", func(t *testing.T) {
+		data, err := json.Marshal(NewOrderedMap[string, int]())
+		AssertNil(t, err)
+		AssertEqual(t, string(data), `{}`)
+	})
+
+	t.Run("marshals non-string keys", func(t *testing.T) {
+		m := NewOrderedMap[int, string]()
+		m.Set(10, "ten")
+		m.Set(2, "two")
+		data, err := json.Marshal(m)
+		AssertNil(t, err)
+		AssertEqual(t, string(data), `{"10":"ten","2":"two"}`)
+
+		u := NewOrderedMap[ULID, int]()
+		id, err := ParseULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+		AssertNil(t, err)
+		u.Set(id, 1)
+		data, err = json.Marshal(u)
+		AssertNil(t, err)
+		AssertEqual(t, string(data), `{"01ARZ3NDEKTSV4RRFFQ69G5FAV":1}`)
+	})
+
+	t.Run("unmarshals keeping order", func(t *testing.T) {
+		m := NewOrderedMap[string, int]()
+		err := json.Unmarshal([]byte(`{"b": 2, "a": 1, "c": 3}`), m)
+		AssertNil(t, err)
+		AssertEqual(t, m.Keys(), []string{"b", "a", "c"})
+
+		v, _ := m.Get("a")
+		AssertEqual(t, v, 1)
+	})
+
+	t.Run("round trips", func(t *testing.T) {
+		m := NewOrderedMap[int, []string]()
+		m.Set(3, []string{"c"})
+		m.Set(1, []string{"a", "b"})
+
+		data, err := json.Marshal(m)
+		AssertNil(t, err)
+
+		var decoded OrderedMap[int, []string]
+		AssertNil(t, json.Unmarshal(data, &decoded))
+		AssertEqual(t, decoded.Keys(), []int{3, 1})
+		v, _ := decoded.Get(1)
+		AssertEqual(t, v, []string{"a", "b"})
+	})
+
+	t.Run("rejects invalid input", func(t *testing.T) {
+		var m OrderedMap[int, int]
+		AssertNotNil(t, json.Unmarshal([]byte(`[1, 2]`), &m))
+		AssertNotNil(t, json.Unmarshal([]byte(`{"x": 1}`), &m))
+		AssertNotNil(t, json.Unmarshal([]byte(`{"1": "one"}`), &m))
+	})
+}
@@ -0,0 +1,172 @@
+package pocket
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	passwordAlgorithm = "argon2id"
+	passwordSaltLen   = 16
+	passwordKeyLen    = 32
+
+	// Upper bounds for stored hashes, so that a crafted hash cannot make VerifyPassword arbitrarily expensive.
+	passwordMaxMemory  = 1 << 20 // KiB, i.e. 1 GiB
+	passwordMaxTime    = 10
+	passwordMinSaltLen = 8
+	passwordMaxSaltLen = 64
+	passwordMinKeyLen  = 16
+	passwordMaxKeyLen  = 64
+)
+
+// passwordParams are the argon2id cost parameters.
+type passwordParams struct {
+	memory  uint32 // KiB
+	time    uint32
+	threads uint8
+}
+
+// defaultPasswordParams follow the RFC 9106 recommendation for memory-constrained environments:
+// 64 MiB of memory, 3 passes and 4 lanes.
+var defaultPasswordParams = passwordParams{memory: 64 * 1024, time: 3, threads: 4}
+
+// ErrInvalidPasswordHash is returned when a stored hash is not in the format produced by HashPassword.
+var ErrInvalidPasswordHash = errors.New("invalid password hash")
+
+// HashPassword derives a hash from the password using argon2id with a random salt.
+// The result is a PHC string carrying the algorithm version and parameters alongside the salt and key,
+// e.g. "$argon2id$v=19$m=65536,t=3,p=4$<salt>$<key>", so it can be verified (and upgraded) later.
+func HashPassword(password string) (string, error) {
+	return hashPassword(password, defaultPasswordParams)
+}
+
+func hashPassword(password string, params passwordParams) (string, error) {
+	salt := make([]byte, passwordSaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("cannot read random bytes: %w", err)
+	}
+
+	key := argon2.IDKey([]byte(password), salt, params.time, params.memory, params.threads, passwordKeyLen)
+
+	return fmt.Sprintf(
+		"$%s$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		passwordAlgorithm,
+		argon2.Version,
+		params.memory,
+		params.time,
+		params.threads,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+// VerifyPassword reports whether the password matches a hash produced by HashPassword.
+// The comparison is done in constant time.
+// Returns ErrInvalidPasswordHash if the hash cannot be parsed, has an unknown version,
+// asks for more than 1 GiB of memory or 10 passes, or has a salt or key of unexpected length.
+func VerifyPassword(hash, password string) (bool, error) {
+	p, err := parsePasswordHash(hash)
+	if err != nil {
+		return false, err
+	}
+
+	key := argon2.IDKey([]byte(password), p.salt, p.params.time, p.params.memory, p.params.threads, uint32(len(p.key)))
+	return subtle.ConstantTimeCompare(key, p.key) == 1, nil
+}
+
+// PasswordNeedsRehash reports whether a hash was produced with weaker parameters than HashPassword uses now
+// (or is not a valid hash at all) and should be replaced.
+// Call it after a successful VerifyPassword, while the plain password is at hand, and store a fresh HashPassword.
+func PasswordNeedsRehash(hash string) bool {
+	p, err := parsePasswordHash(hash)
+	if err != nil {
+		return true
+	}
+	return p.params.memory < defaultPasswordParams.memory ||
+		p.params.time < defaultPasswordParams.time ||
+		len(p.key) < passwordKeyLen
+}
+
+type passwordHash struct {
+	params passwordParams
+	salt   []byte
+	key    []byte
+}
+
+func parsePasswordHash(hash string) (passwordHash, error) {
+	// The leading "$" yields an empty first part.
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != passwordAlgorithm {
+		return passwordHash{}, ErrInvalidPasswordHash
+	}
+
+	rawVersion, ok := strings.CutPrefix(parts[2], "v=")
+	if !ok {
+		return passwordHash{}, ErrInvalidPasswordHash
+	}
+	version, err := strconv.Atoi(rawVersion)
+	if err != nil {
+		return passwordHash{}, fmt.Errorf("%w: bad version: %w", ErrInvalidPasswordHash, err)
+	}
+	if version != argon2.Version {
+		return passwordHash{}, fmt.Errorf("%w: unknown version %d", ErrInvalidPasswordHash, version)
+	}
+
+	var p passwordHash
+	if p.params, err = parsePasswordParams(parts[3]); err != nil {
+		return passwordHash{}, fmt.Errorf("%w: %w", ErrInvalidPasswordHash, err)
+	}
+
+	if p.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return passwordHash{}, fmt.Errorf("%w: bad salt: %w", ErrInvalidPasswordHash, err)
+	}
+	if len(p.salt) < passwordMinSaltLen || len(p.salt) > passwordMaxSaltLen {
+		return passwordHash{}, fmt.Errorf("%w: bad salt length %d", ErrInvalidPasswordHash, len(p.salt))
+	}
+	if p.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(p.key) < passwordMinKeyLen || len(p.key) > passwordMaxKeyLen {
+		return passwordHash{}, fmt.Errorf("%w: bad key", ErrInvalidPasswordHash)
+	}
+
+	return p, nil
+}
+
+// parsePasswordParams parses the "m=65536,t=3,p=4" section of a hash.
+func parsePasswordParams(s string) (passwordParams, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 3 {
+		return passwordParams{}, fmt.Errorf("bad parameters %q", s)
+	}
+
+	values := make([]uint64, len(parts))
+	for i, name := range []string{"m=", "t=", "p="} {
+		raw, ok := strings.CutPrefix(parts[i], name)
+		if !ok {
+			return passwordParams{}, fmt.Errorf("bad parameters %q", s)
+		}
+		v, err := strconv.ParseUint(raw, 10, 32)
+		if err != nil {
+			return passwordParams{}, fmt.Errorf("bad parameter %q: %w", parts[i], err)
+		}
+		values[i] = v
+	}
+
+	params := passwordParams{memory: uint32(values[0]), time: uint32(values[1])}
+	switch {
+	case values[2] < 1 || values[2] > 255:
+		return passwordParams{}, fmt.Errorf("bad parallelism %d", values[2])
+	case params.time < 1 || params.time > passwordMaxTime:
+		return passwordParams{}, fmt.Errorf("bad time %d", params.time)
+	case params.memory < 8*uint32(values[2]) || params.memory > passwordMaxMemory:
+		// argon2 needs at least 8 KiB per lane.
+		return passwordParams{}, fmt.Errorf("bad memory %d", params.memory)
+	}
+	params.threads = uint8(values[2])
+	return params, nil
+}
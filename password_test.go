@@ -0,0 +1,100 @@
+package pocket
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHashPassword(t *testing.T) {
+	t.Parallel()
+
+	hash, err := HashPassword("correct horse battery staple")
+	AssertNil(t, err)
+	AssertTrue(t, strings.HasPrefix(hash, "$argon2id$v=19$m=65536,t=3,p=4$"))
+
+	t.Run("verifies the right password", func(t *testing.T) {
+		ok, err := VerifyPassword(hash, "correct horse battery staple")
+		AssertNil(t, err)
+		AssertTrue(t, ok)
+	})
+
+	t.Run("rejects a wrong password", func(t *testing.T) {
+		ok, err := VerifyPassword(hash, "Tr0ub4dor&3")
+		AssertNil(t, err)
+		AssertFalse(t, ok)
+	})
+
+	t.Run("uses a random salt", func(t *testing.T) {
+		other, err := HashPassword("correct horse battery staple")
+		AssertNil(t, err)
+		AssertNotEqual(t, hash, other)
+	})
+
+	t.Run("does not need rehash", func(t *testing.T) {
+		AssertFalse(t, PasswordNeedsRehash(hash))
+	})
+}
+
+func TestVerifyPasswordInvalidHash(t *testing.T) {
+	const salt, key = "c2FsdHNhbHQ", "MDEyMzQ1Njc4OWFiY2RlZg"
+	tests := []struct {
+		name string
+		hash string
+	}{
+		{name: "empty", hash: ""},
+		{name: "other algorithm", hash: "$pbkdf2-sha256$v=1$i=1000$" + salt + "$" + key},
+		{name: "argon2i", hash: "$argon2i$v=19$m=64,t=1,p=1$" + salt + "$" + key},
+		{name: "missing parts", hash: "$argon2id$v=19$m=64,t=1,p=1$" + salt},
+		{name: "bad version", hash: "$argon2id$v=x$m=64,t=1,p=1$" + salt + "$" + key},
+		{name: "unknown version", hash: "$argon2id$v=16$m=64,t=1,p=1$" + salt + "$" + key},
+		{name: "missing parameter", hash: "$argon2id$v=19$m=64,t=1$" + salt + "$" + key},
+		{name: "misordered parameters", hash: "$argon2id$v=19$t=1,m=64,p=1$" + salt + "$" + key},
+		{name: "too much memory", hash: "$argon2id$v=19$m=1048577,t=1,p=1$" + salt + "$" + key},
+		{name: "too little memory", hash: "$argon2id$v=19$m=8,t=1,p=2$" + salt + "$" + key},
+		{name: "huge memory", hash: "$argon2id$v=19$m=18446744073709551615,t=1,p=1$" + salt + "$" + key},
+		{name: "zero time", hash: "$argon2id$v=19$m=64,t=0,p=1$" + salt + "$" + key},
+		{name: "too many passes", hash: "$argon2id$v=19$m=64,t=11,p=1$" + salt + "$" + key},
+		{name: "zero parallelism", hash: "$argon2id$v=19$m=64,t=1,p=0$" + salt + "$" + key},
+		{name: "too much parallelism", hash: "$argon2id$v=19$m=4096,t=1,p=256$" + salt + "$" + key},
+		{name: "bad salt", hash: "$argon2id$v=19$m=64,t=1,p=1$!!$" + key},
+		{name: "salt too short", hash: "$argon2id$v=19$m=64,t=1,p=1$c2FsdA$" + key},
+		{name: "salt too long", hash: "$argon2id$v=19$m=64,t=1,p=1$" + strings.Repeat("A", 87) + "$" + key},
+		{name: "empty key", hash: "$argon2id$v=19$m=64,t=1,p=1$" + salt + "$"},
+		{name: "key too short", hash: "$argon2id$v=19$m=64,t=1,p=1$" + salt + "$a2V5"},
+		{name: "key too long", hash: "$argon2id$v=19$m=64,t=1,p=1$" + salt + "$" + strings.Repeat("A", 87)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			ok, err := VerifyPassword(tt.hash, "password")
+			AssertErrorIs(t, err, ErrInvalidPasswordHash)
+			AssertFalse(t, ok)
+			AssertTrue(t, PasswordNeedsRehash(tt.hash))
+		})
+	}
+}
+
+func TestPasswordNeedsRehash(t *testing.T) {
+	t.Run("weaker memory", func(t *testing.T) {
+		hash, err := hashPassword("password", passwordParams{memory: 64, time: 3, threads: 1})
+		AssertNil(t, err)
+		AssertTrue(t, PasswordNeedsRehash(hash))
+
+		ok, err := VerifyPassword(hash, "password")
+		AssertNil(t, err)
+		AssertTrue(t, ok)
+	})
+
+	t.Run("fewer passes", func(t *testing.T) {
+		hash, err := hashPassword("password", passwordParams{memory: 64 * 1024, time: 1, threads: 4})
+		AssertNil(t, err)
+		AssertTrue(t, PasswordNeedsRehash(hash))
+	})
+
+	t.Run("stronger parameters", func(t *testing.T) {
+		hash, err := hashPassword("password", passwordParams{memory: 64 * 1024, time: 4, threads: 2})
+		AssertNil(t, err)
+		AssertFalse(t, PasswordNeedsRehash(hash))
+	})
+}
@@ -0,0 +1,115 @@
+package pocket
+
+import "container/heap"
+
+// PriorityQueue is a heap-based queue that pops elements in the order defined by a comparison function,
+// hiding the heap.Interface boilerplate. It is not safe for concurrent use.
+type PriorityQueue[T any] struct {
+	h priorityHeap[T]
+}
+
+// PriorityItem is a handle to an element in a PriorityQueue, used to update or remove it.
+type PriorityItem[T any] struct {
+	value T
+	index int // position in the heap, -1 once popped or removed
+}
+
+// Value returns the element held by the item.
+func (it *PriorityItem[T]) Value() T {
+	return it.value
+}
+
+// NewPriorityQueue returns an empty PriorityQueue. compare works like the functions used with slices.SortFunc:
+// it returns a negative number when a should be popped before b, e.g. cmp.Compare for a min-queue.
+func NewPriorityQueue[T any](compare func(a, b T) int) *PriorityQueue[T] {
+	return &PriorityQueue[T]{h: priorityHeap[T]{compare: compare}}
+}
+
+// Push adds v to the queue and returns a handle to it.
+func (pq *PriorityQueue[T]) Push(v T) *PriorityItem[T] {
+	item := &PriorityItem[T]{value: v}
+	heap.Push(&pq.h, item)
+	return item
+}
+
+// Pop removes and returns the first element. The boolean is false if the queue is empty.
+func (pq *PriorityQueue[T]) Pop() (T, bool) {
+	if len(pq.h.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return heap.Pop(&pq.h).(*PriorityItem[T]).value, true
+}
+
+// Peek returns the first element without removing it. The boolean is false if the queue is empty.
+func (pq *PriorityQueue[T]) Peek() (T, bool) {
+	if len(pq.h.items) == 0 {
+		var zero T
+		return zero, false
+	}
+	return pq.h.items[0].value, true
+}
+
+// UpdatePriority replaces the element held by item and moves it to its new place in the queue.
+// It returns false if the item is no longer in the queue.
+func (pq *PriorityQueue[T]) UpdatePriority(item *PriorityItem[T], v T) bool {
+	if !pq.contains(item) {
+		return false
+	}
+	item.value = v
+	heap.Fix(&pq.h, item.index)
+	return true
+}
+
+// Remove removes item from the queue. It returns false if the item is no longer in the queue.
+func (pq *PriorityQueue[T]) Remove(item *PriorityItem[T]) bool {
+	if !pq.contains(item) {
+		return false
+	}
+	heap.Remove(&pq.h, item.index)
+	return true
+}
+
+// Len returns the number of elements.
+func (pq *PriorityQueue[T]) Len() int {
+	return len(pq.h.items)
+}
+
+func (pq *PriorityQueue[T]) contains(item *PriorityItem[T]) bool {
+	return item.index >= 0 && item.index < len(pq.h.items) && pq.h.items[item.index] == item
+}
+
+// priorityHeap implements heap.Interface.
+type priorityHeap[T any] struct {
+	items   []*PriorityItem[T]
+	compare func(a, b T) int
+}
+
+func (h priorityHeap[T]) Len() int {
+	return len(h.items)
+}
+
+func (h priorityHeap[T]) Less(i, j int) bool {
+	return h.compare(h.items[i].value, h.items[j].value) < 0
+}
+
+func (h priorityHeap[T]) Swap(i, j int) {
+	h.items[i], h.items[j] = h.items[j], h.items[i]
+	h.items[i].index = i
+	h.items[j].index = j
+}
+
+func (h *priorityHeap[T]) Push(x any) {
+	item := x.(*PriorityItem[T])
+	item.index = len(h.items)
+	h.items = append(h.items, item)
+}
+
+func (h *priorityHeap[T]) Pop() any {
+	n := len(h.items) - 1
+	item := h.items[n]
+	h.items[n] = nil
+	h.items = h.items[:n]
+	item.index = -1
+	return item
+}
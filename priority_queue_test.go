@@ -0,0 +1,77 @@
+package pocket
+
+import (
+	"cmp"
+	"testing"
+)
+
+func TestPriorityQueue(t *testing.T) {
+	t.Run("pops in priority order", func(t *testing.T) {
+		pq := NewPriorityQueue(cmp.Compare[int])
+		for _, v := range []int{5, 1, 4, 2, 3} {
+			pq.Push(v)
+		}
+		AssertEqual(t, pq.Len(), 5)
+
+		top, ok := pq.Peek()
+		AssertTrue(t, ok)
+		AssertEqual(t, top, 1)
+
+		var got []int
+		for pq.Len() > 0 {
+			v, _ := pq.Pop()
+			got = append(got, v)
+		}
+		AssertEqual(t, got, []int{1, 2, 3, 4, 5})
+	})
+
+	t.Run("empty queue", func(t *testing.T) {
+		pq := NewPriorityQueue(cmp.Compare[int])
+		_, ok := pq.Pop()
+		AssertFalse(t, ok)
+		_, ok = pq.Peek()
+		AssertFalse(t, ok)
+	})
+
+	t.Run("max queue for top-k", func(t *testing.T) {
+		pq := NewPriorityQueue(func(a, b int) int { return cmp.Compare(b, a) })
+		for _, v := range []int{3, 9, 1, 7} {
+			pq.Push(v)
+		}
+		first, _ := pq.Pop()
+		second, _ := pq.Pop()
+		AssertEqual(t, []int{first, second}, []int{9, 7})
+	})
+
+	t.Run("update priority", func(t *testing.T) {
+		type job struct {
+			name     string
+			priority int
+		}
+		pq := NewPriorityQueue(func(a, b job) int { return cmp.Compare(a.priority, b.priority) })
+		pq.Push(job{"a", 1})
+		b := pq.Push(job{"b", 2})
+		pq.Push(job{"c", 3})
+
+		AssertTrue(t, pq.UpdatePriority(b, job{"b", 0}))
+		AssertEqual(t, b.Value(), job{"b", 0})
+
+		first, _ := pq.Pop()
+		AssertEqual(t, first.name, "b")
+		AssertFalse(t, pq.UpdatePriority(b, job{"b", 5}))
+	})
+
+	t.Run("remove", func(t *testing.T) {
+		pq := NewPriorityQueue(cmp.Compare[string])
+		pq.Push("b")
+		a := pq.Push("a")
+		pq.Push("c")
+
+		AssertTrue(t, pq.Remove(a))
+		AssertFalse(t, pq.Remove(a))
+
+		first, _ := pq.Pop()
+		AssertEqual(t, first, "b")
+		AssertEqual(t, pq.Len(), 1)
+	})
+}
@@ -0,0 +1,305 @@
+package pocket
+
+import (
+	"cmp"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// Require* functions mirror the Assert* functions but stop the test immediately on failure,
+// so the test does not continue past a check that invalidates everything after it.
+//
+// Example:
+//
+//	user, err := repo.Get(id)
+//	RequireNil(t, err)
+//	AssertEqual(t, user.Name, "Alice") // safe, user is not nil
+
+// RequireNotNil asserts that the given value is not nil, stopping the test otherwise.
+func RequireNotNil(t *testing.T, got any, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertNotNil(t, got, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireNil asserts that the given value is nil, stopping the test otherwise.
+func RequireNil(t *testing.T, got any, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertNil(t, got, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireTrue asserts that the given value is true, stopping the test otherwise.
+func RequireTrue(t *testing.T, got bool, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertTrue(t, got, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireFalse asserts that the given value is false, stopping the test otherwise.
+func RequireFalse(t *testing.T, got bool, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertFalse(t, got, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireEqual asserts that the given values are equal, stopping the test otherwise.
+func RequireEqual[T any](t *testing.T, a T, b T, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertEqual(t, a, b, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireEqualWith is like RequireEqual, customizing the comparison with EqualOption values.
+func RequireEqualWith[T any](t *testing.T, a T, b T, opts []EqualOption, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertEqualWith(t, a, b, opts, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireNotEqual asserts that the given values are not equal, stopping the test otherwise.
+func RequireNotEqual[T any](t *testing.T, a T, b T, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertNotEqual(t, a, b, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireNotEqualWith is like RequireNotEqual, customizing the comparison with EqualOption values.
+func RequireNotEqualWith[T any](t *testing.T, a T, b T, opts []EqualOption, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertNotEqualWith(t, a, b, opts, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireMoneyEqual asserts that the given Money values are equal, stopping the test otherwise.
+func RequireMoneyEqual(t *testing.T, got Money, want Money, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertMoneyEqual(t, got, want, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireErrorIs asserts that the given error is of the given type, stopping the test otherwise.
+func RequireErrorIs(t *testing.T, got error, want error, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertErrorIs(t, got, want, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireContains asserts that the given string contains the given substring, stopping the test otherwise.
+func RequireContains(t *testing.T, got string, substr string, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertContains(t, got, substr, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireNotContains asserts that the given string does not contain the given substring, stopping the test otherwise.
+func RequireNotContains(t *testing.T, got string, substr string, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertNotContains(t, got, substr, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequirePanics asserts that the given function panics, stopping the test otherwise.
+func RequirePanics(t *testing.T, f func(), msgAndArgs ...any) {
+	t.Helper()
+	if !AssertPanics(t, f, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequirePanicsWith asserts that the given function panics with a value equal to want, stopping the test otherwise.
+func RequirePanicsWith(t *testing.T, f func(), want any, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertPanicsWith(t, f, want, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequirePanicsMatch asserts that the given function panics with a value containing substr, stopping the test otherwise.
+func RequirePanicsMatch(t *testing.T, f func(), substr string, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertPanicsMatch(t, f, substr, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireNotPanics asserts that the given function does not panic, stopping the test otherwise.
+func RequireNotPanics(t *testing.T, f func(), msgAndArgs ...any) {
+	t.Helper()
+	if !AssertNotPanics(t, f, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireSorted asserts that the given slice is sorted in ascending order, stopping the test otherwise.
+func RequireSorted[T cmp.Ordered](t *testing.T, s []T, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertSorted(t, s, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireSortedBy asserts that the given slice is sorted according to compare, stopping the test otherwise.
+func RequireSortedBy[T any](t *testing.T, s []T, compare func(a, b T) int, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertSortedBy(t, s, compare, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireEventually asserts that cond returns true within timeout, stopping the test otherwise.
+func RequireEventually(t *testing.T, cond func() bool, timeout, interval time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertEventually(t, cond, timeout, interval, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireEventuallyWithClock is like RequireEventually but measures time with the given Clock.
+func RequireEventuallyWithClock(t *testing.T, clock Clock, cond func() bool, timeout, interval time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertEventuallyWithClock(t, clock, cond, timeout, interval, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireNever asserts that cond does not return true within timeout, stopping the test otherwise.
+func RequireNever(t *testing.T, cond func() bool, timeout, interval time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertNever(t, cond, timeout, interval, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireNeverWithClock is like RequireNever but measures time with the given Clock.
+func RequireNeverWithClock(t *testing.T, clock Clock, cond func() bool, timeout, interval time.Duration, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertNeverWithClock(t, clock, cond, timeout, interval, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireSliceContains asserts that the given slice contains elem, stopping the test otherwise.
+func RequireSliceContains[T any](t *testing.T, s []T, elem T, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertSliceContains(t, s, elem, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireSliceNotContains asserts that the given slice does not contain elem, stopping the test otherwise.
+func RequireSliceNotContains[T any](t *testing.T, s []T, elem T, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertSliceNotContains(t, s, elem, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireElementsMatch asserts that both slices contain the same elements ignoring order, stopping the test otherwise.
+func RequireElementsMatch[T any](t *testing.T, a []T, b []T, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertElementsMatch(t, a, b, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireSubset asserts that every element of subset is present in superset, stopping the test otherwise.
+func RequireSubset[T any](t *testing.T, subset []T, superset []T, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertSubset(t, subset, superset, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireEmpty asserts that the given value is empty, stopping the test otherwise.
+func RequireEmpty(t *testing.T, got any, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertEmpty(t, got, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireNotEmpty asserts that the given value is not empty, stopping the test otherwise.
+func RequireNotEmpty(t *testing.T, got any, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertNotEmpty(t, got, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireGreater asserts that got is greater than bound, stopping the test otherwise.
+func RequireGreater[T cmp.Ordered](t *testing.T, got T, bound T, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertGreater(t, got, bound, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireGreaterOrEqual asserts that got is greater than or equal to bound, stopping the test otherwise.
+func RequireGreaterOrEqual[T cmp.Ordered](t *testing.T, got T, bound T, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertGreaterOrEqual(t, got, bound, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireLess asserts that got is less than bound, stopping the test otherwise.
+func RequireLess[T cmp.Ordered](t *testing.T, got T, bound T, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertLess(t, got, bound, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireLessOrEqual asserts that got is less than or equal to bound, stopping the test otherwise.
+func RequireLessOrEqual[T cmp.Ordered](t *testing.T, got T, bound T, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertLessOrEqual(t, got, bound, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireBetween asserts that got is within [low, high], stopping the test otherwise.
+func RequireBetween[T cmp.Ordered](t *testing.T, got T, low T, high T, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertBetween(t, got, low, high, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireHTTPStatus asserts that the recorded response has the given status code, stopping the test otherwise.
+func RequireHTTPStatus(t *testing.T, rec *httptest.ResponseRecorder, want int, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertHTTPStatus(t, rec, want, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireHTTPHeader asserts that the recorded response has the given header value, stopping the test otherwise.
+func RequireHTTPHeader(t *testing.T, rec *httptest.ResponseRecorder, key string, want string, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertHTTPHeader(t, rec, key, want, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// RequireHTTPBodyJSON asserts that the recorded response body is JSON equivalent to want, stopping the test otherwise.
+func RequireHTTPBodyJSON(t *testing.T, rec *httptest.ResponseRecorder, want any, msgAndArgs ...any) {
+	t.Helper()
+	if !AssertHTTPBodyJSON(t, rec, want, msgAndArgs...) {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,91 @@
+package pocket
+
+import "fmt"
+
+// Result holds either a value or an error, so chains of fallible operations
+// can be composed with MapResult and AndThen instead of an error check after every step.
+// The zero value is an Ok result holding the zero value of T.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok returns a successful Result holding v.
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err returns a failed Result holding err. Panics if err is nil.
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		panic("pocket.Err called with a nil error")
+	}
+	return Result[T]{err: err}
+}
+
+// ResultFrom builds a Result from a conventional (value, error) pair:
+// a failed Result if err is not nil, a successful one otherwise.
+func ResultFrom[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Result[T]{err: err}
+	}
+	return Result[T]{value: v}
+}
+
+// IsOk reports whether the Result holds a value.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr reports whether the Result holds an error.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Err returns the error, or nil for a successful Result.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Get returns the Result as a conventional (value, error) pair.
+func (r Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// Unwrap returns the value, panicking if the Result holds an error.
+func (r Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(fmt.Errorf("unwrap of failed result: %w", r.err))
+	}
+	return r.value
+}
+
+// UnwrapOr returns the value, or fallback if the Result holds an error.
+func (r Result[T]) UnwrapOr(fallback T) T {
+	if r.err != nil {
+		return fallback
+	}
+	return r.value
+}
+
+// MapResult applies f to the value of a successful Result. A failed Result is passed through unchanged.
+func MapResult[T any, U any](r Result[T], f func(T) U) Result[U] {
+	if r.err != nil {
+		return Result[U]{err: r.err}
+	}
+	return Ok(f(r.value))
+}
+
+// AndThen applies the fallible f to the value of a successful Result. A failed Result is passed through unchanged.
+//
+// Example:
+//
+//	total := pocket.AndThen(pocket.ResultFrom(price.Times(qty)), func(m pocket.Money) pocket.Result[pocket.Money] {
+//		return pocket.ResultFrom(m.Plus(shipping))
+//	})
+func AndThen[T any, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Result[U]{err: r.err}
+	}
+	return f(r.value)
+}
@@ -0,0 +1,84 @@
+package pocket
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestResult(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	t.Run("ok", func(t *testing.T) {
+		r := Ok(42)
+		AssertTrue(t, r.IsOk())
+		AssertFalse(t, r.IsErr())
+		AssertNil(t, r.Err())
+		AssertEqual(t, r.Unwrap(), 42)
+		AssertEqual(t, r.UnwrapOr(0), 42)
+
+		v, err := r.Get()
+		AssertNil(t, err)
+		AssertEqual(t, v, 42)
+	})
+
+	t.Run("err", func(t *testing.T) {
+		r := Err[int](errBoom)
+		AssertFalse(t, r.IsOk())
+		AssertTrue(t, r.IsErr())
+		AssertErrorIs(t, r.Err(), errBoom)
+		AssertEqual(t, r.UnwrapOr(7), 7)
+		AssertPanicsMatch(t, func() { r.Unwrap() }, "boom")
+
+		_, err := r.Get()
+		AssertErrorIs(t, err, errBoom)
+	})
+
+	t.Run("Err panics on nil error", func(t *testing.T) {
+		AssertPanics(t, func() { Err[int](nil) })
+	})
+
+	t.Run("from", func(t *testing.T) {
+		AssertEqual(t, ResultFrom(strconv.Atoi("12")).Unwrap(), 12)
+		AssertErrorIs(t, ResultFrom(strconv.Atoi("x")).Err(), strconv.ErrSyntax)
+	})
+}
+
+func TestMapResult(t *testing.T) {
+	double := func(i int) int { return i * 2 }
+
+	AssertEqual(t, MapResult(Ok(2), double).Unwrap(), 4)
+	AssertEqual(t, MapResult(Ok(2), strconv.Itoa).Unwrap(), "2")
+
+	errBoom := errors.New("boom")
+	AssertErrorIs(t, MapResult(Err[int](errBoom), double).Err(), errBoom)
+}
+
+func TestAndThen(t *testing.T) {
+	parse := func(s string) Result[int] { return ResultFrom(strconv.Atoi(s)) }
+
+	AssertEqual(t, AndThen(Ok("12"), parse).Unwrap(), 12)
+	AssertErrorIs(t, AndThen(Ok("x"), parse).Err(), strconv.ErrSyntax)
+
+	errBoom := errors.New("boom")
+	called := false
+	r := AndThen(Err[string](errBoom), func(s string) Result[int] {
+		called = true
+		return parse(s)
+	})
+	AssertErrorIs(t, r.Err(), errBoom)
+	AssertFalse(t, called)
+
+	t.Run("chains money operations", func(t *testing.T) {
+		shipping := NewUSD(500)
+		total := AndThen(ResultFrom(NewUSD(1999).Times(3)), func(m Money) Result[Money] {
+			return ResultFrom(m.Plus(shipping))
+		})
+		AssertMoneyEqual(t, total.Unwrap(), NewUSD(6497))
+
+		mixed := AndThen(ResultFrom(NewUSD(1999).Times(3)), func(m Money) Result[Money] {
+			return ResultFrom(m.Plus(NewARS(500)))
+		})
+		AssertTrue(t, mixed.IsErr())
+	})
+}
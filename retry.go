@@ -0,0 +1,99 @@
+package pocket
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// RetryPolicy configures Retry and RetryValue. Zero fields take the defaults noted on each one.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of calls, including the first one. Defaults to 3.
+	MaxAttempts int
+	// InitialDelay is the wait before the first retry. Defaults to 100ms.
+	InitialDelay time.Duration
+	// MaxDelay caps the wait between attempts. Defaults to no cap.
+	MaxDelay time.Duration
+	// Multiplier grows the delay after every retry. Defaults to 2 (exponential backoff).
+	Multiplier float64
+	// Jitter randomly shortens each delay by up to this fraction (0 to 1), to spread out retries
+	// from many clients failing at once. Defaults to 0 (no jitter).
+	Jitter float64
+	// RetryIf decides whether an error is worth retrying. Defaults to retrying every error.
+	RetryIf func(err error) bool
+	// OnRetry, if set, is called before waiting for each retry with the failed attempt number (starting at 1),
+	// its error and the delay before the next attempt. Useful for logging and metrics.
+	OnRetry func(attempt int, err error, delay time.Duration)
+	// Backoff, if set, computes the delay before each retry instead of InitialDelay, MaxDelay, Multiplier and Jitter.
+	// Returning BackoffStop gives up, as does reaching MaxAttempts.
+	Backoff Backoff
+	// Clock is used to wait between attempts. Defaults to RealClock; use a FakeClock in tests.
+	Clock Clock
+}
+
+// Retry calls fn until it succeeds, the policy gives up, or ctx is done.
+// On giving up, it returns the last error, annotated with the number of attempts.
+// Errors rejected by RetryIf are returned unchanged, immediately.
+// If ctx is done while waiting, the context error is returned along with the last error.
+func Retry(ctx context.Context, policy RetryPolicy, fn func(ctx context.Context) error) error {
+	_, err := RetryValue(ctx, policy, func(ctx context.Context) (struct{}, error) {
+		return struct{}{}, fn(ctx)
+	})
+	return err
+}
+
+// RetryValue works like Retry for functions that return a value.
+func RetryValue[T any](ctx context.Context, policy RetryPolicy, fn func(ctx context.Context) (T, error)) (T, error) {
+	policy = policy.withDefaults()
+	var zero T
+
+	for attempt := 1; ; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return zero, err
+		}
+
+		v, err := fn(ctx)
+		if err == nil {
+			return v, nil
+		}
+		if policy.RetryIf != nil && !policy.RetryIf(err) {
+			return zero, err
+		}
+		wait := BackoffStop
+		if attempt < policy.MaxAttempts {
+			wait = policy.Backoff.Next(attempt)
+		}
+		if wait < 0 {
+			return zero, fmt.Errorf("giving up after %d attempt(s): %w", attempt, err)
+		}
+		if policy.OnRetry != nil {
+			policy.OnRetry(attempt, err, wait)
+		}
+
+		select {
+		case <-policy.Clock.After(wait):
+		case <-ctx.Done():
+			return zero, fmt.Errorf("%w (last error: %w)", ctx.Err(), err)
+		}
+	}
+}
+
+func (p RetryPolicy) withDefaults() RetryPolicy {
+	if p.MaxAttempts <= 0 {
+		p.MaxAttempts = 3
+	}
+	if p.InitialDelay <= 0 {
+		p.InitialDelay = 100 * time.Millisecond
+	}
+	if p.Multiplier <= 0 {
+		p.Multiplier = 2
+	}
+	p.Jitter = min(max(p.Jitter, 0), 1)
+	if p.Backoff == nil {
+		p.Backoff = ExponentialBackoff{Initial: p.InitialDelay, Max: p.MaxDelay, Multiplier: p.Multiplier, Jitter: p.Jitter}
+	}
+	if p.Clock == nil {
+		p.Clock = RealClock{}
+	}
+	return p
+}
@@ -0,0 +1,210 @@
+package pocket
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+var errTemporary = errors.New("temporary")
+
+// failingTimes returns a function that fails n times before succeeding.
+func failingTimes(n int) (fn func(context.Context) error, calls *int) {
+	calls = new(int)
+	return func(context.Context) error {
+		*calls++
+		if *calls <= n {
+			return errTemporary
+		}
+		return nil
+	}, calls
+}
+
+// advanceWhileWaiting keeps advancing the clock by d whenever Retry is waiting, until done is closed.
+func advanceWhileWaiting(clock *FakeClock, d time.Duration, done <-chan struct{}) {
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+		if clock.Waiters() > 0 {
+			clock.Advance(d)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestRetry(t *testing.T) {
+	t.Run("succeeds after failures", func(t *testing.T) {
+		clock := NewFakeClock(epoch)
+		fn, calls := failingTimes(2)
+
+		var delays []time.Duration
+		policy := RetryPolicy{
+			MaxAttempts:  5,
+			InitialDelay: time.Second,
+			Clock:        clock,
+			OnRetry: func(attempt int, err error, delay time.Duration) {
+				AssertErrorIs(t, err, errTemporary)
+				delays = append(delays, delay)
+			},
+		}
+
+		done := make(chan struct{})
+		go advanceWhileWaiting(clock, time.Second, done)
+		err := Retry(context.Background(), policy, fn)
+		close(done)
+
+		AssertNil(t, err)
+		AssertEqual(t, *calls, 3)
+		AssertEqual(t, delays, []time.Duration{time.Second, 2 * time.Second})
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		clock := NewFakeClock(epoch)
+		fn, calls := failingTimes(10)
+
+		done := make(chan struct{})
+		go advanceWhileWaiting(clock, time.Minute, done)
+		err := Retry(context.Background(), RetryPolicy{MaxAttempts: 3, Clock: clock}, fn)
+		close(done)
+
+		AssertErrorIs(t, err, errTemporary)
+		AssertContains(t, err.Error(), "giving up after 3 attempt(s)")
+		AssertEqual(t, *calls, 3)
+	})
+
+	t.Run("caps the delay", func(t *testing.T) {
+		clock := NewFakeClock(epoch)
+		fn, _ := failingTimes(4)
+
+		var delays []time.Duration
+		policy := RetryPolicy{
+			MaxAttempts:  5,
+			InitialDelay: time.Second,
+			MaxDelay:     3 * time.Second,
+			Multiplier:   2,
+			Clock:        clock,
+			OnRetry:      func(_ int, _ error, d time.Duration) { delays = append(delays, d) },
+		}
+
+		done := make(chan struct{})
+		go advanceWhileWaiting(clock, time.Minute, done)
+		AssertNil(t, Retry(context.Background(), policy, fn))
+		close(done)
+
+		AssertEqual(t, delays, []time.Duration{time.Second, 2 * time.Second, 3 * time.Second, 3 * time.Second})
+	})
+
+	t.Run("applies jitter", func(t *testing.T) {
+		clock := NewFakeClock(epoch)
+		fn, _ := failingTimes(20)
+
+		var delays []time.Duration
+		policy := RetryPolicy{
+			MaxAttempts:  20,
+			InitialDelay: time.Second,
+			Multiplier:   1,
+			Jitter:       0.5,
+			Clock:        clock,
+			OnRetry:      func(_ int, _ error, d time.Duration) { delays = append(delays, d) },
+		}
+
+		done := make(chan struct{})
+		go advanceWhileWaiting(clock, time.Minute, done)
+		_ = Retry(context.Background(), policy, fn)
+		close(done)
+
+		for _, d := range delays {
+			AssertBetween(t, d, 500*time.Millisecond, time.Second)
+		}
+		AssertTrue(t, Any(delays, func(d time.Duration) bool { return d < time.Second }))
+	})
+
+	t.Run("uses a custom backoff", func(t *testing.T) {
+		clock := NewFakeClock(epoch)
+		fn, calls := failingTimes(10)
+
+		var delays []time.Duration
+		policy := RetryPolicy{
+			MaxAttempts: 10,
+			Backoff:     WithMaxElapsed(LinearBackoff{Initial: time.Second, Step: time.Second}, 6*time.Second),
+			Clock:       clock,
+			OnRetry:     func(_ int, _ error, d time.Duration) { delays = append(delays, d) },
+		}
+
+		done := make(chan struct{})
+		go advanceWhileWaiting(clock, time.Minute, done)
+		err := Retry(context.Background(), policy, fn)
+		close(done)
+
+		AssertErrorIs(t, err, errTemporary)
+		AssertContains(t, err.Error(), "giving up after 4 attempt(s)")
+		AssertEqual(t, *calls, 4)
+		AssertEqual(t, delays, []time.Duration{time.Second, 2 * time.Second, 3 * time.Second})
+	})
+
+	t.Run("stops on non-retryable errors", func(t *testing.T) {
+		errFatal := errors.New("fatal")
+		calls := 0
+		policy := RetryPolicy{
+			MaxAttempts: 5,
+			RetryIf:     func(err error) bool { return !errors.Is(err, errFatal) },
+		}
+
+		err := Retry(context.Background(), policy, func(context.Context) error {
+			calls++
+			return errFatal
+		})
+		AssertEqual(t, err, errFatal)
+		AssertEqual(t, calls, 1)
+	})
+
+	t.Run("stops when the context is cancelled while waiting", func(t *testing.T) {
+		clock := NewFakeClock(epoch)
+		ctx, cancel := context.WithCancel(context.Background())
+		fn, calls := failingTimes(10)
+
+		go func() {
+			clock.BlockUntil(1)
+			cancel()
+		}()
+		err := Retry(ctx, RetryPolicy{MaxAttempts: 5, Clock: clock}, fn)
+
+		AssertErrorIs(t, err, context.Canceled)
+		AssertErrorIs(t, err, errTemporary)
+		AssertEqual(t, *calls, 1)
+	})
+
+	t.Run("does not call fn with a done context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		fn, calls := failingTimes(0)
+
+		err := Retry(ctx, RetryPolicy{}, fn)
+		AssertErrorIs(t, err, context.Canceled)
+		AssertEqual(t, *calls, 0)
+	})
+}
+
+func TestRetryValue(t *testing.T) {
+	clock := NewFakeClock(epoch)
+	calls := 0
+
+	done := make(chan struct{})
+	go advanceWhileWaiting(clock, time.Second, done)
+	v, err := RetryValue(context.Background(), RetryPolicy{Clock: clock}, func(context.Context) (string, error) {
+		calls++
+		if calls < 2 {
+			return "", errTemporary
+		}
+		return "ok", nil
+	})
+	close(done)
+
+	AssertNil(t, err)
+	AssertEqual(t, v, "ok")
+	AssertEqual(t, calls, 2)
+}
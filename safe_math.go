@@ -20,6 +20,11 @@ type Int interface {
 	Signed | Unsigned
 }
 
+// Number is a type constraint that matches all integer and floating-point types.
+type Number interface {
+	Int | ~float32 | ~float64
+}
+
 // SafeAdd returns the sum of two integers, panicking if the result overflows.
 func SafeAdd[T Int](a T, b T) T {
 	result, err := TrySafeAdd(a, b)
@@ -84,8 +89,8 @@ func TrySafeSub[T Int](a T, b T) (T, error) {
 	case int, int8, int16, int32, int64:
 		result := a - b
 
-		// If a positive minus a negative gives a negative, overflow occurred.
-		if a > 0 && b < 0 && result < 0 {
+		// If a non-negative minus a negative gives a negative, overflow occurred.
+		if a >= 0 && b < 0 && result < 0 {
 			return zero, fmt.Errorf("integer overflow: %v - %v", a, b)
 		}
 
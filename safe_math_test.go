@@ -154,6 +154,12 @@ func TestSafeSub(t *testing.T) {
 			b:           1,
 			shouldPanic: true,
 		},
+		{
+			name:        "zero - min int overflows",
+			a:           0,
+			b:           math.MinInt,
+			shouldPanic: true,
+		},
 	}
 	for _, tt := range intTests {
 		t.Run(tt.name, func(t *testing.T) {
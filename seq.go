@@ -0,0 +1,48 @@
+package pocket
+
+import "iter"
+
+// MapSeq returns an iterator that applies the given function to each element of seq as it is consumed.
+// Use slices.Values to start a pipeline from a slice and slices.Collect to materialize the result.
+func MapSeq[T any, U any](seq iter.Seq[T], f func(T) U) iter.Seq[U] {
+	return func(yield func(U) bool) {
+		for v := range seq {
+			if !yield(f(v)) {
+				return
+			}
+		}
+	}
+}
+
+// FilterSeq returns an iterator over the elements of seq for which the given function returns true.
+// Like MapSeq, it composes with slices.Values and slices.Collect.
+func FilterSeq[T any](seq iter.Seq[T], f func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if f(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeSeq returns an iterator over at most the first n elements of seq.
+// It stops pulling from seq once n elements have been yielded, so it can be used on infinite sequences.
+// Pass the result to slices.Collect to get the elements as a slice.
+func TakeSeq[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		taken := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			taken++
+			if taken == n {
+				return
+			}
+		}
+	}
+}
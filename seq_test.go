@@ -0,0 +1,48 @@
+package pocket
+
+import (
+	"iter"
+	"slices"
+	"strconv"
+	"testing"
+)
+
+// naturals is an infinite sequence used to prove the helpers are lazy.
+func naturals(pulled *int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; ; i++ {
+			*pulled++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestMapSeq(t *testing.T) {
+	seq := MapSeq(slices.Values([]int{1, 2, 3}), strconv.Itoa)
+	AssertEqual(t, slices.Collect(seq), []string{"1", "2", "3"})
+}
+
+func TestFilterSeq(t *testing.T) {
+	seq := FilterSeq(slices.Values([]int{1, 2, 3, 4}), func(i int) bool { return i%2 == 0 })
+	AssertEqual(t, slices.Collect(seq), []int{2, 4})
+}
+
+func TestTakeSeq(t *testing.T) {
+	AssertEqual(t, slices.Collect(TakeSeq(slices.Values([]int{1, 2, 3}), 2)), []int{1, 2})
+	AssertEqual(t, slices.Collect(TakeSeq(slices.Values([]int{1, 2, 3}), 5)), []int{1, 2, 3})
+	AssertEqual(t, len(slices.Collect(TakeSeq(slices.Values([]int{1, 2, 3}), 0))), 0)
+}
+
+func TestSeqPipelineIsLazy(t *testing.T) {
+	pulled := 0
+	isEven := func(i int) bool { return i%2 == 0 }
+	square := func(i int) int { return i * i }
+
+	seq := TakeSeq(MapSeq(FilterSeq(naturals(&pulled), isEven), square), 3)
+	AssertEqual(t, pulled, 0)
+
+	AssertEqual(t, slices.Collect(seq), []int{0, 4, 16})
+	AssertEqual(t, pulled, 5)
+}
@@ -0,0 +1,130 @@
+package pocket
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// ShutdownHook is a cleanup step run by Shutdown.
+type ShutdownHook struct {
+	// Name identifies the hook in errors.
+	Name string
+	// Priority orders the hooks: lower values run first. Hooks with the same priority
+	// run in registration order. E.g. stop accepting requests (0) before closing the database (10).
+	Priority int
+	// Timeout bounds this hook. Defaults to no limit other than the overall Shutdown deadline.
+	Timeout time.Duration
+	// Fn does the cleanup. It should return promptly once its context is done.
+	Fn func(ctx context.Context) error
+}
+
+// Shutdown coordinates a graceful shutdown: it waits for SIGINT or SIGTERM
+// and then runs the registered hooks in priority order, within an overall deadline.
+// It is safe for concurrent use.
+type Shutdown struct {
+	timeout time.Duration
+	clock   Clock
+
+	mu    sync.Mutex
+	hooks []ShutdownHook
+	once  sync.Once
+	err   error
+}
+
+// ShutdownOption configures a Shutdown.
+type ShutdownOption func(*Shutdown)
+
+// WithShutdownClock sets the Clock that measures the overall and per-hook timeouts, e.g. a FakeClock in tests.
+// Defaults to RealClock.
+func WithShutdownClock(clock Clock) ShutdownOption {
+	return func(s *Shutdown) {
+		s.clock = clock
+	}
+}
+
+// NewShutdown returns a Shutdown whose hooks must all complete within timeout.
+// A timeout <= 0 means no overall deadline.
+func NewShutdown(timeout time.Duration, opts ...ShutdownOption) *Shutdown {
+	s := &Shutdown{timeout: timeout, clock: RealClock{}}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Register adds a hook. It panics if hook.Fn is nil.
+func (s *Shutdown) Register(hook ShutdownHook) {
+	if hook.Fn == nil {
+		panic(fmt.Sprintf("shutdown hook %q has no Fn", hook.Name))
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.hooks = append(s.hooks, hook)
+}
+
+// Wait blocks until the process receives SIGINT or SIGTERM, or ctx is done, and then runs the hooks.
+// The hooks do not inherit ctx's cancellation, only its values.
+func (s *Shutdown) Wait(ctx context.Context) error {
+	sigCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	<-sigCtx.Done()
+	stop()
+	return s.Run(context.WithoutCancel(ctx))
+}
+
+// Run runs the hooks one at a time in priority order and returns their errors joined, each prefixed with its hook's name.
+// Every hook runs even if an earlier one fails; a panicking hook is reported as an error.
+// Once the overall deadline passes, the remaining hooks are skipped and reported as such.
+// Only the first call runs the hooks; later calls return the same result.
+func (s *Shutdown) Run(ctx context.Context) error {
+	s.once.Do(func() {
+		s.err = s.run(ctx)
+	})
+	return s.err
+}
+
+func (s *Shutdown) run(ctx context.Context) error {
+	s.mu.Lock()
+	hooks := slices.Clone(s.hooks)
+	s.mu.Unlock()
+	slices.SortStableFunc(hooks, func(a, b ShutdownHook) int { return cmp.Compare(a.Priority, b.Priority) })
+
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = withClockTimeout(ctx, s.clock, s.timeout)
+		defer cancel()
+	}
+
+	var errs []error
+	for _, hook := range hooks {
+		if ctx.Err() != nil {
+			errs = append(errs, fmt.Errorf("%s: skipped: %w", hook.Name, context.Cause(ctx)))
+			continue
+		}
+		if err := runShutdownHook(ctx, s.clock, hook); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", hook.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func runShutdownHook(ctx context.Context, clock Clock, hook ShutdownHook) (err error) {
+	if hook.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = withClockTimeout(ctx, clock, hook.Timeout)
+		defer cancel()
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return hook.Fn(ctx)
+}
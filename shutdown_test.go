@@ -0,0 +1,174 @@
+package pocket
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/signal"
+	"syscall"
+	"testing"
+	"time"
+)
+
+func TestShutdown(t *testing.T) {
+	t.Run("runs hooks in priority order", func(t *testing.T) {
+		t.Parallel()
+		s := NewShutdown(time.Second)
+		var order []string
+		record := func(name string, priority int) {
+			s.Register(ShutdownHook{Name: name, Priority: priority, Fn: func(context.Context) error {
+				order = append(order, name)
+				return nil
+			}})
+		}
+		record("db", 10)
+		record("http", 0)
+		record("cache", 10)
+		record("queue", 5)
+
+		AssertNil(t, s.Run(context.Background()))
+		AssertEqual(t, order, []string{"http", "queue", "db", "cache"})
+	})
+
+	t.Run("joins errors and keeps going", func(t *testing.T) {
+		t.Parallel()
+		s := NewShutdown(0)
+		errFlush := errors.New("flush failed")
+		ran := false
+		s.Register(ShutdownHook{Name: "metrics", Fn: func(context.Context) error { return errFlush }})
+		s.Register(ShutdownHook{Name: "cache", Fn: func(context.Context) error { panic("boom") }})
+		s.Register(ShutdownHook{Name: "db", Fn: func(context.Context) error { ran = true; return nil }})
+
+		err := s.Run(context.Background())
+		AssertErrorIs(t, err, errFlush)
+		AssertContains(t, err.Error(), "metrics: flush failed")
+		AssertContains(t, err.Error(), "cache: panic: boom")
+		AssertTrue(t, ran)
+	})
+
+	t.Run("hook timeout", func(t *testing.T) {
+		t.Parallel()
+		s := NewShutdown(time.Second)
+		s.Register(ShutdownHook{Name: "slow", Timeout: 5 * time.Millisecond, Fn: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}})
+
+		err := s.Run(context.Background())
+		AssertErrorIs(t, err, context.DeadlineExceeded)
+		AssertContains(t, err.Error(), "slow: ")
+	})
+
+	t.Run("overall deadline skips remaining hooks", func(t *testing.T) {
+		t.Parallel()
+		s := NewShutdown(5 * time.Millisecond)
+		ran := false
+		s.Register(ShutdownHook{Name: "slow", Fn: func(ctx context.Context) error {
+			<-ctx.Done()
+			return nil
+		}})
+		s.Register(ShutdownHook{Name: "db", Priority: 1, Fn: func(context.Context) error { ran = true; return nil }})
+
+		err := s.Run(context.Background())
+		AssertErrorIs(t, err, context.DeadlineExceeded)
+		AssertContains(t, err.Error(), "db: skipped")
+		AssertFalse(t, ran)
+	})
+
+	t.Run("deadlines follow the given clock", func(t *testing.T) {
+		t.Parallel()
+		clock := NewFakeClock(epoch)
+		s := NewShutdown(time.Minute, WithShutdownClock(clock))
+		s.Register(ShutdownHook{Name: "http", Timeout: 10 * time.Second, Fn: func(ctx context.Context) error {
+			<-ctx.Done()
+			return context.Cause(ctx)
+		}})
+		slowStarted := make(chan struct{})
+		s.Register(ShutdownHook{Name: "slow", Priority: 1, Fn: func(ctx context.Context) error {
+			close(slowStarted)
+			<-ctx.Done()
+			return nil
+		}})
+		s.Register(ShutdownHook{Name: "db", Priority: 2, Fn: func(context.Context) error { return nil }})
+
+		done := make(chan error, 1)
+		go func() { done <- s.Run(context.Background()) }()
+
+		// The overall deadline and the first hook's timeout.
+		clock.BlockUntil(2)
+		clock.Advance(10 * time.Second)
+		<-slowStarted
+		clock.Advance(50 * time.Second)
+
+		err := <-done
+		AssertErrorIs(t, err, context.DeadlineExceeded)
+		AssertContains(t, err.Error(), "http: context deadline exceeded")
+		AssertContains(t, err.Error(), "db: skipped: context deadline exceeded")
+		AssertNotContains(t, err.Error(), "slow")
+	})
+
+	t.Run("runs only once", func(t *testing.T) {
+		t.Parallel()
+		s := NewShutdown(0)
+		calls := 0
+		s.Register(ShutdownHook{Name: "once", Fn: func(context.Context) error { calls++; return errors.New("failed") }})
+
+		err1 := s.Run(context.Background())
+		err2 := s.Run(context.Background())
+		AssertEqual(t, calls, 1)
+		AssertEqual(t, err1, err2)
+	})
+
+	t.Run("register without fn panics", func(t *testing.T) {
+		t.Parallel()
+		AssertPanicsWith(t, func() {
+			NewShutdown(0).Register(ShutdownHook{Name: "empty"})
+		}, `shutdown hook "empty" has no Fn`)
+	})
+
+	t.Run("wait runs hooks when ctx is done", func(t *testing.T) {
+		t.Parallel()
+		s := NewShutdown(time.Second)
+		ran := false
+		s.Register(ShutdownHook{Name: "db", Fn: func(ctx context.Context) error {
+			ran = true
+			return ctx.Err()
+		}})
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		AssertNil(t, s.Wait(ctx))
+		AssertTrue(t, ran)
+	})
+}
+
+func TestShutdownWaitSignal(t *testing.T) {
+	// Catch SIGTERM for the whole test so a signal sent before Wait is listening doesn't kill the process.
+	caught := make(chan os.Signal, 1)
+	signal.Notify(caught, syscall.SIGTERM)
+	defer signal.Stop(caught)
+
+	s := NewShutdown(time.Second)
+	done := make(chan struct{})
+	s.Register(ShutdownHook{Name: "signal", Fn: func(context.Context) error {
+		close(done)
+		return nil
+	}})
+
+	errs := make(chan error, 1)
+	go func() { errs <- s.Wait(context.Background()) }()
+
+	// Keep signalling until Wait has installed its handler and run the hooks.
+	proc, err := os.FindProcess(os.Getpid())
+	AssertNil(t, err)
+	RequireEventually(t, func() bool {
+		select {
+		case <-done:
+			return true
+		default:
+			_ = proc.Signal(syscall.SIGTERM)
+			return false
+		}
+	}, time.Second, 10*time.Millisecond)
+	AssertNil(t, <-errs)
+}
@@ -1,5 +1,15 @@
 package pocket
 
+import (
+	"cmp"
+	"crypto/rand"
+	"fmt"
+	"math"
+	"math/big"
+	mrand "math/rand/v2"
+	"slices"
+)
+
 // Map applies the given function to each element of the slice and returns a new slice with the results.
 func Map[T any, U any](slice []T, f func(T) U) []U {
 	result := make([]U, len(slice))
@@ -19,3 +29,541 @@ func Filter[T any](slice []T, f func(T) bool) []T {
 	}
 	return result
 }
+
+// Find returns the first element of the slice for which the function returns true.
+// The boolean is false if no element matches.
+func Find[T any](slice []T, f func(T) bool) (T, bool) {
+	if i := FindIndex(slice, f); i >= 0 {
+		return slice[i], true
+	}
+	var zero T
+	return zero, false
+}
+
+// FindIndex returns the index of the first element of the slice for which the function returns true, or -1 if none does.
+func FindIndex[T any](slice []T, f func(T) bool) int {
+	for i, v := range slice {
+		if f(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindLast returns the last element of the slice for which the function returns true.
+// The boolean is false if no element matches.
+func FindLast[T any](slice []T, f func(T) bool) (T, bool) {
+	for i := len(slice) - 1; i >= 0; i-- {
+		if f(slice[i]) {
+			return slice[i], true
+		}
+	}
+	var zero T
+	return zero, false
+}
+
+// Chunk splits the slice into consecutive chunks of the given size; the last chunk may be smaller.
+// Chunks share the backing array of the input, so modifying an element is visible in both,
+// but their capacity is capped so appending to a chunk never overwrites the next one.
+// Clone the chunks (e.g. with slices.Clone) if they must be independent.
+// Panics if size is not positive.
+func Chunk[T any](slice []T, size int) [][]T {
+	if size <= 0 {
+		panic(fmt.Sprintf("chunk size must be positive, got %d", size))
+	}
+
+	chunks := make([][]T, 0, (len(slice)+size-1)/size)
+	for i := 0; i < len(slice); i += size {
+		end := min(i+size, len(slice))
+		chunks = append(chunks, slice[i:end:end])
+	}
+	return chunks
+}
+
+// Partition splits the slice in two: the elements for which the function returns true, and the rest.
+// Both results keep the original order.
+func Partition[T any](slice []T, f func(T) bool) (matching, rest []T) {
+	matching = make([]T, 0, len(slice))
+	rest = make([]T, 0, len(slice))
+	for _, v := range slice {
+		if f(v) {
+			matching = append(matching, v)
+		} else {
+			rest = append(rest, v)
+		}
+	}
+	return matching, rest
+}
+
+// Shuffle randomly reorders the slice in place using the Fisher–Yates algorithm.
+// It uses math/rand/v2, which is fast but not suitable when the order must be unpredictable; use ShuffleCrypto for that.
+func Shuffle[T any](slice []T) {
+	shuffle(slice, mrand.IntN)
+}
+
+// ShuffleCrypto randomly reorders the slice in place using the Fisher–Yates algorithm and crypto/rand,
+// for cases where the order must be fair and unpredictable (e.g. drawing winners).
+// If for any reason `rand.Int` fails, this function will panic!
+func ShuffleCrypto[T any](slice []T) {
+	shuffle(slice, cryptoIntN)
+}
+
+// Sample returns n distinct elements of the slice (distinct by position), chosen at random without replacement.
+// If n exceeds the length of the slice, all elements are returned in random order.
+// It uses reservoir sampling, so it only allocates the n elements of the result.
+// Like Shuffle, it uses math/rand/v2; use SampleCrypto when the choice must be unpredictable.
+// Panics if n is negative.
+func Sample[T any](slice []T, n int) []T {
+	return sample(slice, n, mrand.IntN)
+}
+
+// SampleCrypto works like Sample, but uses crypto/rand.
+// If for any reason `rand.Int` fails, this function will panic!
+func SampleCrypto[T any](slice []T, n int) []T {
+	return sample(slice, n, cryptoIntN)
+}
+
+func shuffle[T any](slice []T, intN func(int) int) {
+	for i := len(slice) - 1; i > 0; i-- {
+		j := intN(i + 1)
+		slice[i], slice[j] = slice[j], slice[i]
+	}
+}
+
+func sample[T any](slice []T, n int, intN func(int) int) []T {
+	if n < 0 {
+		panic(fmt.Sprintf("sample size must not be negative, got %d", n))
+	}
+
+	n = min(n, len(slice))
+	reservoir := make([]T, n)
+	copy(reservoir, slice[:n])
+	for i := n; i < len(slice); i++ {
+		if j := intN(i + 1); j < n {
+			reservoir[j] = slice[i]
+		}
+	}
+
+	// The reservoir keeps the first elements in their original positions, so shuffle to randomize the order too.
+	shuffle(reservoir, intN)
+	return reservoir
+}
+
+// cryptoIntN returns a uniform random number in [0, n) from crypto/rand, panicking if it cannot be read.
+func cryptoIntN(n int) int {
+	v, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		panic(fmt.Errorf("cannot read random number: %w", err))
+	}
+	return int(v.Int64())
+}
+
+// Sum returns the sum of all elements of the slice, or zero for an empty slice.
+// Integer overflow wraps around silently; use TrySum to detect it.
+func Sum[T Number](slice []T) T {
+	var sum T
+	for _, v := range slice {
+		sum += v
+	}
+	return sum
+}
+
+// TrySum returns the sum of all elements of the slice.
+// Returns an error if the sum would overflow or underflow (see TrySafeAdd).
+func TrySum[T Int](slice []T) (T, error) {
+	var sum T
+	for _, v := range slice {
+		var err error
+		if sum, err = TrySafeAdd(sum, v); err != nil {
+			return 0, err
+		}
+	}
+	return sum, nil
+}
+
+// Min returns the smallest element of the slice.
+// The boolean is false if the slice is empty.
+func Min[T cmp.Ordered](slice []T) (T, bool) {
+	return MinBy(slice, func(v T) T { return v })
+}
+
+// Max returns the largest element of the slice.
+// The boolean is false if the slice is empty.
+func Max[T cmp.Ordered](slice []T) (T, bool) {
+	return MaxBy(slice, func(v T) T { return v })
+}
+
+// MinBy returns the element of the slice with the smallest key, as returned by the given function.
+// Ties go to the earliest element. The boolean is false if the slice is empty.
+func MinBy[T any, K cmp.Ordered](slice []T, key func(T) K) (T, bool) {
+	return extremeBy(slice, key, -1)
+}
+
+// MaxBy returns the element of the slice with the largest key, as returned by the given function.
+// Ties go to the earliest element. The boolean is false if the slice is empty.
+func MaxBy[T any, K cmp.Ordered](slice []T, key func(T) K) (T, bool) {
+	return extremeBy(slice, key, 1)
+}
+
+// extremeBy returns the element whose key compares as want (-1 for min, 1 for max) against all others.
+func extremeBy[T any, K cmp.Ordered](slice []T, key func(T) K, want int) (T, bool) {
+	if len(slice) == 0 {
+		var zero T
+		return zero, false
+	}
+
+	best, bestKey := slice[0], key(slice[0])
+	for _, v := range slice[1:] {
+		if k := key(v); cmp.Compare(k, bestKey) == want {
+			best, bestKey = v, k
+		}
+	}
+	return best, true
+}
+
+// Any reports whether the function returns true for at least one element of the slice.
+// It stops at the first match and returns false for an empty slice.
+func Any[T any](slice []T, f func(T) bool) bool {
+	return FindIndex(slice, f) >= 0
+}
+
+// All reports whether the function returns true for every element of the slice.
+// It stops at the first mismatch and returns true for an empty slice.
+func All[T any](slice []T, f func(T) bool) bool {
+	for _, v := range slice {
+		if !f(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// None reports whether the function returns false for every element of the slice.
+// It stops at the first match and returns true for an empty slice.
+func None[T any](slice []T, f func(T) bool) bool {
+	return !Any(slice, f)
+}
+
+// Difference returns the distinct elements of a that are not in b, in the order they first appear in a.
+func Difference[T comparable](a, b []T) []T {
+	exclude := toSet(b)
+	return distinctWhere(a, func(v T) bool {
+		_, found := exclude[v]
+		return !found
+	})
+}
+
+// Intersect returns the distinct elements of a that are also in b, in the order they first appear in a.
+func Intersect[T comparable](a, b []T) []T {
+	include := toSet(b)
+	return distinctWhere(a, func(v T) bool {
+		_, found := include[v]
+		return found
+	})
+}
+
+// Union returns the distinct elements of a followed by the distinct elements of b not already in a, in order of first appearance.
+func Union[T comparable](a, b []T) []T {
+	all := make([]T, 0, len(a)+len(b))
+	all = append(append(all, a...), b...)
+	return distinctWhere(all, func(T) bool { return true })
+}
+
+func toSet[T comparable](slice []T) map[T]struct{} {
+	set := make(map[T]struct{}, len(slice))
+	for _, v := range slice {
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+// distinctWhere returns the elements for which the function returns true, skipping repeated ones.
+func distinctWhere[T comparable](slice []T, f func(T) bool) []T {
+	seen := make(map[T]struct{}, len(slice))
+	result := make([]T, 0, len(slice))
+	for _, v := range slice {
+		if _, dup := seen[v]; dup || !f(v) {
+			continue
+		}
+		seen[v] = struct{}{}
+		result = append(result, v)
+	}
+	return result
+}
+
+// SortBy sorts the slice in place in ascending order of the key returned by the given function.
+// The sort is not stable; use SortStableBy to keep the original order of elements with equal keys.
+func SortBy[T any, K cmp.Ordered](slice []T, key func(T) K) {
+	slices.SortFunc(slice, func(a, b T) int { return cmp.Compare(key(a), key(b)) })
+}
+
+// SortByDesc sorts the slice in place in descending order of the key returned by the given function.
+func SortByDesc[T any, K cmp.Ordered](slice []T, key func(T) K) {
+	slices.SortFunc(slice, func(a, b T) int { return cmp.Compare(key(b), key(a)) })
+}
+
+// SortStableBy sorts the slice in place in ascending order of the key returned by the given function,
+// keeping the original order of elements with equal keys.
+func SortStableBy[T any, K cmp.Ordered](slice []T, key func(T) K) {
+	slices.SortStableFunc(slice, func(a, b T) int { return cmp.Compare(key(a), key(b)) })
+}
+
+// SortStableByDesc sorts the slice in place in descending order of the key returned by the given function,
+// keeping the original order of elements with equal keys.
+func SortStableByDesc[T any, K cmp.Ordered](slice []T, key func(T) K) {
+	slices.SortStableFunc(slice, func(a, b T) int { return cmp.Compare(key(b), key(a)) })
+}
+
+// SortByChain sorts the slice in place using several comparators:
+// elements are ordered by the first one, ties are broken by the second one, and so on.
+// The sort is stable, so elements equal under every comparator keep their original order.
+//
+// Example:
+//
+//	pocket.SortByChain(people,
+//		pocket.CompareBy(func(p Person) string { return p.LastName }),
+//		pocket.Descending(pocket.CompareBy(func(p Person) int { return p.Age })),
+//	)
+func SortByChain[T any](slice []T, compares ...func(a, b T) int) {
+	slices.SortStableFunc(slice, Then(compares...))
+}
+
+// MapErr applies the given function to each element of the slice and returns a new slice with the results.
+// It stops at the first error, which is returned wrapped with the index of the failing element.
+func MapErr[T any, U any](slice []T, f func(T) (U, error)) ([]U, error) {
+	result := make([]U, len(slice))
+	for i, v := range slice {
+		u, err := f(v)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		result[i] = u
+	}
+	return result, nil
+}
+
+// FilterErr returns a new slice with the elements for which the given function returns true.
+// It stops at the first error, which is returned wrapped with the index of the failing element.
+func FilterErr[T any](slice []T, f func(T) (bool, error)) ([]T, error) {
+	result := make([]T, 0, len(slice))
+	for i, v := range slice {
+		keep, err := f(v)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %w", i, err)
+		}
+		if keep {
+			result = append(result, v)
+		}
+	}
+	return result, nil
+}
+
+// FilterMap applies the given function to each element of the slice and returns a new slice with the results
+// for which the function also returned true, transforming and filtering in a single pass.
+func FilterMap[T any, U any](slice []T, f func(T) (U, bool)) []U {
+	result := make([]U, 0, len(slice))
+	for _, v := range slice {
+		if u, ok := f(v); ok {
+			result = append(result, u)
+		}
+	}
+	return result
+}
+
+// Windows returns every run of size consecutive elements of the slice, each starting one element after the previous,
+// e.g. [1 2 3 4] with size 2 gives [[1 2] [2 3] [3 4]]. A slice shorter than size has no windows.
+// Like Chunk, windows share the backing array of the input with their capacity capped.
+// Panics if size is not positive.
+func Windows[T any](slice []T, size int) [][]T {
+	return WindowsStep(slice, size, 1)
+}
+
+// WindowsStep works like Windows, but each window starts step elements after the previous one.
+// Panics if size or step is not positive.
+func WindowsStep[T any](slice []T, size, step int) [][]T {
+	if size <= 0 {
+		panic(fmt.Sprintf("window size must be positive, got %d", size))
+	}
+	if step <= 0 {
+		panic(fmt.Sprintf("window step must be positive, got %d", step))
+	}
+
+	if len(slice) < size {
+		return [][]T{}
+	}
+
+	windows := make([][]T, 0, (len(slice)-size)/step+1)
+	for i := 0; i+size <= len(slice); i += step {
+		windows = append(windows, slice[i:i+size:i+size])
+	}
+	return windows
+}
+
+// Compact returns a new slice without the zero-valued elements ("", 0, nil, etc.) of the given one.
+// Note that this differs from slices.Compact, which removes consecutive duplicates.
+func Compact[T comparable](slice []T) []T {
+	var zero T
+	return CompactFunc(slice, func(v T) bool { return v == zero })
+}
+
+// CompactFunc returns a new slice without the elements for which the given function reports emptiness,
+// e.g. strings that are only whitespace.
+func CompactFunc[T any](slice []T, isEmpty func(T) bool) []T {
+	return Filter(slice, func(v T) bool { return !isEmpty(v) })
+}
+
+// KeyBy returns a map of the elements of the slice indexed by the key returned by the given function.
+// If several elements share a key, the last one wins; use KeyByUnique to treat that as an error.
+func KeyBy[T any, K comparable](slice []T, key func(T) K) map[K]T {
+	return ToMap(slice, func(v T) (K, T) { return key(v), v })
+}
+
+// KeyByUnique works like KeyBy, but returns an error if several elements share a key.
+func KeyByUnique[T any, K comparable](slice []T, key func(T) K) (map[K]T, error) {
+	return ToMapUnique(slice, func(v T) (K, T) { return key(v), v })
+}
+
+// ToMap returns a map built from the key-value pairs returned by the given function for each element of the slice.
+// If several elements produce the same key, the last one wins; use ToMapUnique to treat that as an error.
+func ToMap[T any, K comparable, V any](slice []T, f func(T) (K, V)) map[K]V {
+	result := make(map[K]V, len(slice))
+	for _, v := range slice {
+		k, val := f(v)
+		result[k] = val
+	}
+	return result
+}
+
+// ToMapUnique works like ToMap, but returns an error if several elements produce the same key.
+func ToMapUnique[T any, K comparable, V any](slice []T, f func(T) (K, V)) (map[K]V, error) {
+	result := make(map[K]V, len(slice))
+	for i, v := range slice {
+		k, val := f(v)
+		if _, dup := result[k]; dup {
+			return nil, fmt.Errorf("duplicate key %v at element %d", k, i)
+		}
+		result[k] = val
+	}
+	return result, nil
+}
+
+// CountBy returns how many elements of the slice share each key returned by the given function.
+func CountBy[T any, K comparable](slice []T, key func(T) K) map[K]int {
+	counts := make(map[K]int)
+	for _, v := range slice {
+		counts[key(v)]++
+	}
+	return counts
+}
+
+// InsertAt returns a new slice with v inserted at index i, shifting later elements to the right.
+// Valid indices go from 0 to len(slice), inclusive; anything else returns an error.
+// Unlike append-based idioms, the input slice is never modified.
+func InsertAt[T any](slice []T, i int, v T) ([]T, error) {
+	if i < 0 || i > len(slice) {
+		return nil, fmt.Errorf("index %d out of range [0, %d]", i, len(slice))
+	}
+
+	result := make([]T, 0, len(slice)+1)
+	result = append(result, slice[:i]...)
+	result = append(result, v)
+	return append(result, slice[i:]...), nil
+}
+
+// RemoveAt returns a new slice without the element at index i.
+// Returns an error if i is out of range. The input slice is never modified.
+func RemoveAt[T any](slice []T, i int) ([]T, error) {
+	if i < 0 || i >= len(slice) {
+		return nil, fmt.Errorf("index %d out of range [0, %d)", i, len(slice))
+	}
+
+	result := make([]T, 0, len(slice)-1)
+	result = append(result, slice[:i]...)
+	return append(result, slice[i+1:]...), nil
+}
+
+// DeleteFirst returns a new slice without the first occurrence of v.
+// The boolean is false, and the result an unmodified copy, if v is not found. The input slice is never modified.
+func DeleteFirst[T comparable](slice []T, v T) ([]T, bool) {
+	i := slices.Index(slice, v)
+	if i < 0 {
+		return slices.Clone(slice), false
+	}
+	result, _ := RemoveAt(slice, i)
+	return result, true
+}
+
+// Associate returns a map built from a slice of key-value pairs. If a key repeats, the last pair wins.
+// It is the inverse of Entries.
+func Associate[K comparable, V any](pairs []Pair[K, V]) map[K]V {
+	return ToMap(pairs, func(p Pair[K, V]) (K, V) { return p.First, p.Second })
+}
+
+// RangeInt returns the integers from start (inclusive) to end (exclusive), counting by step,
+// e.g. RangeInt(0, 10, 3) gives [0 3 6 9] and RangeInt(5, 0, -2) gives [5 3 1].
+// Panics if step is zero.
+func RangeInt(start, end, step int) []int {
+	if step == 0 {
+		panic("range step must not be zero")
+	}
+
+	result := []int{}
+	for v := start; (step > 0 && v < end) || (step < 0 && v > end); v += step {
+		result = append(result, v)
+		// Stop rather than wrap around when the next value would overflow.
+		if (step > 0 && v > math.MaxInt-step) || (step < 0 && v < math.MinInt-step) {
+			break
+		}
+	}
+	return result
+}
+
+// Repeat returns a slice with n copies of v. Panics if n is negative.
+func Repeat[T any](v T, n int) []T {
+	if n < 0 {
+		panic(fmt.Sprintf("repeat count must not be negative, got %d", n))
+	}
+
+	result := make([]T, n)
+	Fill(result, v)
+	return result
+}
+
+// Fill sets every element of the slice to v, in place.
+func Fill[T any](slice []T, v T) {
+	for i := range slice {
+		slice[i] = v
+	}
+}
+
+// Product returns the Cartesian product of a and b: every pair of an element of a with an element of b,
+// ordered by a first, e.g. [1 2] and ["x" "y"] give [{1 x} {1 y} {2 x} {2 y}].
+func Product[T any, U any](a []T, b []U) []Pair[T, U] {
+	result := make([]Pair[T, U], 0, len(a)*len(b))
+	for _, x := range a {
+		for _, y := range b {
+			result = append(result, Pair[T, U]{First: x, Second: y})
+		}
+	}
+	return result
+}
+
+// ProductN returns the Cartesian product of any number of slices of the same type,
+// each combination holding one element of every slice in order,
+// e.g. [1 2] and [3 4] give [[1 3] [1 4] [2 3] [2 4]].
+// If any slice is empty there are no combinations; with no slices there is a single, empty one.
+func ProductN[T any](inputs ...[]T) [][]T {
+	result := [][]T{{}}
+	for _, s := range inputs {
+		next := make([][]T, 0, len(result)*len(s))
+		for _, combination := range result {
+			for _, v := range s {
+				c := make([]T, len(combination), len(combination)+1)
+				copy(c, combination)
+				next = append(next, append(c, v))
+			}
+		}
+		result = next
+	}
+	return result
+}
@@ -1,6 +1,10 @@
 package pocket
 
 import (
+	"errors"
+	"math"
+	"slices"
+	"strconv"
 	"strings"
 	"testing"
 )
@@ -73,3 +77,726 @@ func TestFilter(t *testing.T) {
 		})
 	}
 }
+
+func TestFind(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	tests := []struct {
+		name      string
+		slice     []int
+		wantFirst int
+		wantLast  int
+		wantIndex int
+		wantOk    bool
+	}{
+		{name: "multiple matches", slice: []int{1, 2, 3, 4, 5}, wantFirst: 2, wantLast: 4, wantIndex: 1, wantOk: true},
+		{name: "single match", slice: []int{1, 3, 6}, wantFirst: 6, wantLast: 6, wantIndex: 2, wantOk: true},
+		{name: "no match", slice: []int{1, 3, 5}, wantIndex: -1},
+		{name: "empty", slice: nil, wantIndex: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			first, ok := Find(tt.slice, isEven)
+			AssertEqual(t, ok, tt.wantOk)
+			AssertEqual(t, first, tt.wantFirst)
+
+			last, ok := FindLast(tt.slice, isEven)
+			AssertEqual(t, ok, tt.wantOk)
+			AssertEqual(t, last, tt.wantLast)
+
+			AssertEqual(t, FindIndex(tt.slice, isEven), tt.wantIndex)
+		})
+	}
+}
+
+func TestChunk(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice []int
+		size  int
+		want  [][]int
+	}{
+		{name: "even split", slice: []int{1, 2, 3, 4}, size: 2, want: [][]int{{1, 2}, {3, 4}}},
+		{name: "uneven split", slice: []int{1, 2, 3, 4, 5}, size: 2, want: [][]int{{1, 2}, {3, 4}, {5}}},
+		{name: "size larger than slice", slice: []int{1, 2}, size: 5, want: [][]int{{1, 2}}},
+		{name: "empty", slice: []int{}, size: 3, want: [][]int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, Chunk(tt.slice, tt.size), tt.want)
+		})
+	}
+
+	t.Run("appending to a chunk does not overwrite the next", func(t *testing.T) {
+		slice := []int{1, 2, 3, 4}
+		chunks := Chunk(slice, 2)
+		_ = append(chunks[0], 99)
+		AssertEqual(t, chunks[1], []int{3, 4})
+		AssertEqual(t, slice, []int{1, 2, 3, 4})
+	})
+
+	t.Run("panics on non-positive size", func(t *testing.T) {
+		AssertPanicsWith(t, func() { Chunk([]int{1}, 0) }, "chunk size must be positive, got 0")
+	})
+}
+
+func TestPartition(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	tests := []struct {
+		name         string
+		slice        []int
+		wantMatching []int
+		wantRest     []int
+	}{
+		{name: "mixed", slice: []int{1, 2, 3, 4, 5}, wantMatching: []int{2, 4}, wantRest: []int{1, 3, 5}},
+		{name: "all match", slice: []int{2, 4}, wantMatching: []int{2, 4}, wantRest: []int{}},
+		{name: "none match", slice: []int{1, 3}, wantMatching: []int{}, wantRest: []int{1, 3}},
+		{name: "empty", slice: nil, wantMatching: []int{}, wantRest: []int{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			matching, rest := Partition(tt.slice, isEven)
+			AssertEqual(t, matching, tt.wantMatching)
+			AssertEqual(t, rest, tt.wantRest)
+		})
+	}
+}
+
+func TestShuffle(t *testing.T) {
+	shufflers := map[string]func([]int){
+		"Shuffle":       Shuffle[int],
+		"ShuffleCrypto": ShuffleCrypto[int],
+	}
+
+	for name, shuffle := range shufflers {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("keeps the same elements", func(t *testing.T) {
+				slice := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+				shuffle(slice)
+				AssertElementsMatch(t, slice, []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10})
+			})
+
+			t.Run("changes the order", func(t *testing.T) {
+				// The chance of 20 shuffles of 10 elements all keeping the original order is negligible.
+				changed := false
+				for range 20 {
+					slice := []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+					shuffle(slice)
+					if !slices.IsSorted(slice) {
+						changed = true
+						break
+					}
+				}
+				AssertTrue(t, changed)
+			})
+
+			t.Run("handles empty and single element slices", func(t *testing.T) {
+				AssertNotPanics(t, func() { shuffle(nil) })
+				single := []int{1}
+				shuffle(single)
+				AssertEqual(t, single, []int{1})
+			})
+		})
+	}
+}
+
+func TestSample(t *testing.T) {
+	samplers := map[string]func([]int, int) []int{
+		"Sample":       Sample[int],
+		"SampleCrypto": SampleCrypto[int],
+	}
+
+	for name, sample := range samplers {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("returns n distinct elements", func(t *testing.T) {
+				slice := make([]int, 100)
+				for i := range slice {
+					slice[i] = i
+				}
+				got := sample(slice, 10)
+				AssertEqual(t, len(got), 10)
+				AssertSubset(t, got, slice)
+				AssertEqual(t, len(Union(got, nil)), 10)
+			})
+
+			t.Run("returns everything when n is too large", func(t *testing.T) {
+				got := sample([]int{1, 2, 3}, 5)
+				AssertElementsMatch(t, got, []int{1, 2, 3})
+			})
+
+			t.Run("returns nothing for n zero or empty input", func(t *testing.T) {
+				AssertEqual(t, sample([]int{1, 2, 3}, 0), []int{})
+				AssertEqual(t, sample(nil, 3), []int{})
+			})
+
+			t.Run("reaches every element", func(t *testing.T) {
+				seen := map[int]bool{}
+				for range 200 {
+					for _, v := range sample([]int{1, 2, 3, 4, 5}, 2) {
+						seen[v] = true
+					}
+				}
+				AssertEqual(t, len(seen), 5)
+			})
+
+			t.Run("panics on negative n", func(t *testing.T) {
+				AssertPanicsWith(t, func() { sample([]int{1}, -1) }, "sample size must not be negative, got -1")
+			})
+		})
+	}
+}
+
+func TestSum(t *testing.T) {
+	AssertEqual(t, Sum([]int{1, 2, 3}), 6)
+	AssertEqual(t, Sum([]int{}), 0)
+	AssertEqual(t, Sum([]float64{0.5, 0.25}), 0.75)
+	AssertEqual(t, Sum([]uint8{100, 100}), uint8(200))
+
+	type cents int64
+	AssertEqual(t, Sum([]cents{150, 250}), cents(400))
+}
+
+func TestTrySum(t *testing.T) {
+	t.Run("sums", func(t *testing.T) {
+		sum, err := TrySum([]int64{1, 2, 3})
+		AssertNil(t, err)
+		AssertEqual(t, sum, int64(6))
+	})
+
+	t.Run("detects overflow", func(t *testing.T) {
+		_, err := TrySum([]int8{100, 27, 1})
+		AssertNotNil(t, err)
+		AssertContains(t, err.Error(), "overflow")
+	})
+
+	t.Run("detects underflow", func(t *testing.T) {
+		_, err := TrySum([]int{math.MinInt, -1})
+		AssertNotNil(t, err)
+		AssertContains(t, err.Error(), "underflow")
+	})
+}
+
+func TestMinMax(t *testing.T) {
+	t.Run("ints", func(t *testing.T) {
+		lowest, ok := Min([]int{3, 1, 2})
+		AssertTrue(t, ok)
+		AssertEqual(t, lowest, 1)
+
+		highest, ok := Max([]int{3, 1, 2})
+		AssertTrue(t, ok)
+		AssertEqual(t, highest, 3)
+	})
+
+	t.Run("strings", func(t *testing.T) {
+		lowest, _ := Min([]string{"pear", "apple", "fig"})
+		AssertEqual(t, lowest, "apple")
+
+		highest, _ := Max([]string{"pear", "apple", "fig"})
+		AssertEqual(t, highest, "pear")
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		_, ok := Min([]int{})
+		AssertFalse(t, ok)
+
+		_, ok = Max([]int(nil))
+		AssertFalse(t, ok)
+	})
+}
+
+func TestMinByMaxBy(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	people := []person{{"Ana", 30}, {"Bo", 25}, {"Cy", 40}, {"Di", 25}, {"Ed", 40}}
+	age := func(p person) int { return p.age }
+
+	youngest, ok := MinBy(people, age)
+	AssertTrue(t, ok)
+	AssertEqual(t, youngest.name, "Bo")
+
+	oldest, ok := MaxBy(people, age)
+	AssertTrue(t, ok)
+	AssertEqual(t, oldest.name, "Cy")
+
+	_, ok = MinBy([]person{}, age)
+	AssertFalse(t, ok)
+}
+
+func TestAnyAllNone(t *testing.T) {
+	isEven := func(i int) bool { return i%2 == 0 }
+
+	tests := []struct {
+		name     string
+		slice    []int
+		wantAny  bool
+		wantAll  bool
+		wantNone bool
+	}{
+		{name: "some match", slice: []int{1, 2, 3}, wantAny: true, wantAll: false, wantNone: false},
+		{name: "all match", slice: []int{2, 4}, wantAny: true, wantAll: true, wantNone: false},
+		{name: "none match", slice: []int{1, 3}, wantAny: false, wantAll: false, wantNone: true},
+		{name: "empty", slice: nil, wantAny: false, wantAll: true, wantNone: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, Any(tt.slice, isEven), tt.wantAny)
+			AssertEqual(t, All(tt.slice, isEven), tt.wantAll)
+			AssertEqual(t, None(tt.slice, isEven), tt.wantNone)
+		})
+	}
+
+	t.Run("short-circuits", func(t *testing.T) {
+		calls := 0
+		counting := func(i int) bool {
+			calls++
+			return isEven(i)
+		}
+
+		Any([]int{2, 1, 1}, counting)
+		AssertEqual(t, calls, 1)
+
+		calls = 0
+		All([]int{1, 2, 2}, counting)
+		AssertEqual(t, calls, 1)
+
+		calls = 0
+		None([]int{2, 1, 1}, counting)
+		AssertEqual(t, calls, 1)
+	})
+}
+
+func TestSetOperations(t *testing.T) {
+	tests := []struct {
+		name          string
+		a             []string
+		b             []string
+		wantDiff      []string
+		wantIntersect []string
+		wantUnion     []string
+	}{
+		{
+			name:          "overlapping",
+			a:             []string{"db", "cache", "queue"},
+			b:             []string{"queue", "search", "db"},
+			wantDiff:      []string{"cache"},
+			wantIntersect: []string{"db", "queue"},
+			wantUnion:     []string{"db", "cache", "queue", "search"},
+		},
+		{
+			name:          "duplicates",
+			a:             []string{"x", "y", "x", "z", "y"},
+			b:             []string{"z", "w", "w"},
+			wantDiff:      []string{"x", "y"},
+			wantIntersect: []string{"z"},
+			wantUnion:     []string{"x", "y", "z", "w"},
+		},
+		{
+			name:          "disjoint",
+			a:             []string{"a"},
+			b:             []string{"b"},
+			wantDiff:      []string{"a"},
+			wantIntersect: []string{},
+			wantUnion:     []string{"a", "b"},
+		},
+		{
+			name:          "empty",
+			a:             nil,
+			b:             nil,
+			wantDiff:      []string{},
+			wantIntersect: []string{},
+			wantUnion:     []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, Difference(tt.a, tt.b), tt.wantDiff)
+			AssertEqual(t, Intersect(tt.a, tt.b), tt.wantIntersect)
+			AssertEqual(t, Union(tt.a, tt.b), tt.wantUnion)
+		})
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	type person struct {
+		name string
+		age  int
+	}
+	age := func(p person) int { return p.age }
+	names := func(people []person) []string {
+		return Map(people, func(p person) string { return p.name })
+	}
+	newPeople := func() []person {
+		return []person{{"Ana", 30}, {"Bo", 25}, {"Cy", 40}, {"Di", 25}}
+	}
+
+	t.Run("SortBy", func(t *testing.T) {
+		people := newPeople()
+		SortBy(people, age)
+		AssertSortedBy(t, people, func(a, b person) int { return a.age - b.age })
+	})
+
+	t.Run("SortByDesc", func(t *testing.T) {
+		people := newPeople()
+		SortByDesc(people, age)
+		AssertSortedBy(t, people, func(a, b person) int { return b.age - a.age })
+	})
+
+	t.Run("SortStableBy", func(t *testing.T) {
+		people := newPeople()
+		SortStableBy(people, age)
+		AssertEqual(t, names(people), []string{"Bo", "Di", "Ana", "Cy"})
+	})
+
+	t.Run("SortStableByDesc", func(t *testing.T) {
+		people := newPeople()
+		SortStableByDesc(people, age)
+		AssertEqual(t, names(people), []string{"Cy", "Ana", "Bo", "Di"})
+	})
+
+	t.Run("SortByChain", func(t *testing.T) {
+		people := []person{{"Di", 25}, {"Ana", 30}, {"Bo", 25}, {"Ana", 20}}
+		SortByChain(people,
+			func(a, b person) int { return a.age - b.age },
+			func(a, b person) int { return strings.Compare(a.name, b.name) },
+		)
+		AssertEqual(t, people, []person{{"Ana", 20}, {"Bo", 25}, {"Di", 25}, {"Ana", 30}})
+	})
+
+	t.Run("SortByChain without comparators keeps order", func(t *testing.T) {
+		people := newPeople()
+		SortByChain(people)
+		AssertEqual(t, people, newPeople())
+	})
+}
+
+func TestMapErr(t *testing.T) {
+	t.Run("maps all elements", func(t *testing.T) {
+		got, err := MapErr([]string{"1", "2", "3"}, strconv.Atoi)
+		AssertNil(t, err)
+		AssertEqual(t, got, []int{1, 2, 3})
+	})
+
+	t.Run("stops at the first error", func(t *testing.T) {
+		calls := 0
+		got, err := MapErr([]string{"1", "x", "y"}, func(s string) (int, error) {
+			calls++
+			return strconv.Atoi(s)
+		})
+		AssertNil(t, got)
+		AssertErrorIs(t, err, strconv.ErrSyntax)
+		AssertContains(t, err.Error(), "element 1")
+		AssertEqual(t, calls, 2)
+	})
+}
+
+func TestFilterErr(t *testing.T) {
+	errNegative := errors.New("negative")
+	isEven := func(i int) (bool, error) {
+		if i < 0 {
+			return false, errNegative
+		}
+		return i%2 == 0, nil
+	}
+
+	t.Run("filters all elements", func(t *testing.T) {
+		got, err := FilterErr([]int{1, 2, 3, 4}, isEven)
+		AssertNil(t, err)
+		AssertEqual(t, got, []int{2, 4})
+	})
+
+	t.Run("stops at the first error", func(t *testing.T) {
+		got, err := FilterErr([]int{2, 4, -1, 6}, isEven)
+		AssertNil(t, got)
+		AssertErrorIs(t, err, errNegative)
+		AssertContains(t, err.Error(), "element 2")
+	})
+}
+
+func TestFilterMap(t *testing.T) {
+	parse := func(s string) (int, bool) {
+		n, err := strconv.Atoi(s)
+		return n, err == nil
+	}
+
+	AssertEqual(t, FilterMap([]string{"1", "x", "3", ""}, parse), []int{1, 3})
+	AssertEqual(t, FilterMap([]string{"x"}, parse), []int{})
+	AssertEqual(t, FilterMap(nil, parse), []int{})
+}
+
+func TestWindows(t *testing.T) {
+	tests := []struct {
+		name  string
+		slice []int
+		size  int
+		step  int
+		want  [][]int
+	}{
+		{name: "pairs", slice: []int{1, 2, 3, 4}, size: 2, step: 1, want: [][]int{{1, 2}, {2, 3}, {3, 4}}},
+		{name: "triples", slice: []int{1, 2, 3, 4}, size: 3, step: 1, want: [][]int{{1, 2, 3}, {2, 3, 4}}},
+		{name: "exact size", slice: []int{1, 2}, size: 2, step: 1, want: [][]int{{1, 2}}},
+		{name: "shorter than size", slice: []int{1}, size: 2, step: 1, want: [][]int{}},
+		{name: "stride", slice: []int{1, 2, 3, 4, 5, 6}, size: 2, step: 2, want: [][]int{{1, 2}, {3, 4}, {5, 6}}},
+		{name: "stride with leftover", slice: []int{1, 2, 3, 4, 5}, size: 3, step: 2, want: [][]int{{1, 2, 3}, {3, 4, 5}}},
+		{name: "stride larger than size", slice: []int{1, 2, 3, 4, 5}, size: 1, step: 3, want: [][]int{{1}, {4}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, WindowsStep(tt.slice, tt.size, tt.step), tt.want)
+			if tt.step == 1 {
+				AssertEqual(t, Windows(tt.slice, tt.size), tt.want)
+			}
+		})
+	}
+
+	t.Run("panics on invalid arguments", func(t *testing.T) {
+		AssertPanicsWith(t, func() { Windows([]int{1}, 0) }, "window size must be positive, got 0")
+		AssertPanicsWith(t, func() { WindowsStep([]int{1}, 1, -1) }, "window step must be positive, got -1")
+	})
+}
+
+func TestCompact(t *testing.T) {
+	AssertEqual(t, Compact([]string{"a", "", "b", ""}), []string{"a", "b"})
+	AssertEqual(t, Compact([]int{0, 1, 0, 2}), []int{1, 2})
+	AssertEqual(t, Compact([]string{"", ""}), []string{})
+
+	one := 1
+	AssertEqual(t, Compact([]*int{nil, &one, nil}), []*int{&one})
+}
+
+func TestCompactFunc(t *testing.T) {
+	isBlank := func(s string) bool { return strings.TrimSpace(s) == "" }
+	AssertEqual(t, CompactFunc([]string{"a", "  ", "b", "\t"}, isBlank), []string{"a", "b"})
+	AssertEqual(t, CompactFunc(nil, isBlank), []string{})
+}
+
+func TestKeyBy(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	id := func(u user) int { return u.id }
+
+	t.Run("indexes by key", func(t *testing.T) {
+		got := KeyBy([]user{{1, "ana"}, {2, "bo"}}, id)
+		AssertEqual(t, got, map[int]user{1: {1, "ana"}, 2: {2, "bo"}})
+	})
+
+	t.Run("last wins on duplicates", func(t *testing.T) {
+		got := KeyBy([]user{{1, "ana"}, {1, "bo"}}, id)
+		AssertEqual(t, got, map[int]user{1: {1, "bo"}})
+	})
+
+	t.Run("unique", func(t *testing.T) {
+		got, err := KeyByUnique([]user{{1, "ana"}, {2, "bo"}}, id)
+		AssertNil(t, err)
+		AssertEqual(t, len(got), 2)
+
+		got, err = KeyByUnique([]user{{1, "ana"}, {2, "bo"}, {1, "cy"}}, id)
+		AssertNil(t, got)
+		AssertNotNil(t, err)
+		AssertEqual(t, err.Error(), "duplicate key 1 at element 2")
+	})
+}
+
+func TestToMap(t *testing.T) {
+	pair := func(s string) (string, int) { return s, len(s) }
+
+	t.Run("builds map", func(t *testing.T) {
+		AssertEqual(t, ToMap([]string{"a", "bb"}, pair), map[string]int{"a": 1, "bb": 2})
+		AssertEqual(t, ToMap(nil, pair), map[string]int{})
+	})
+
+	t.Run("last wins on duplicates", func(t *testing.T) {
+		first := func(s string) (byte, string) { return s[0], s }
+		AssertEqual(t, ToMap([]string{"apple", "avocado"}, first), map[byte]string{'a': "avocado"})
+	})
+
+	t.Run("unique", func(t *testing.T) {
+		got, err := ToMapUnique([]string{"a", "bb"}, pair)
+		AssertNil(t, err)
+		AssertEqual(t, got, map[string]int{"a": 1, "bb": 2})
+
+		_, err = ToMapUnique([]string{"a", "a"}, pair)
+		AssertNotNil(t, err)
+	})
+}
+
+func TestCountBy(t *testing.T) {
+	statuses := []int{200, 404, 200, 500, 201, 503}
+	class := func(code int) string { return strconv.Itoa(code/100) + "xx" }
+
+	AssertEqual(t, CountBy(statuses, class), map[string]int{"2xx": 3, "4xx": 1, "5xx": 2})
+	AssertEqual(t, CountBy(nil, class), map[string]int{})
+}
+
+func TestInsertAt(t *testing.T) {
+	tests := []struct {
+		name string
+		i    int
+		want []int
+	}{
+		{name: "start", i: 0, want: []int{9, 1, 2, 3}},
+		{name: "middle", i: 1, want: []int{1, 9, 2, 3}},
+		{name: "end", i: 3, want: []int{1, 2, 3, 9}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			slice := make([]int, 3, 10)
+			copy(slice, []int{1, 2, 3})
+
+			got, err := InsertAt(slice, tt.i, 9)
+			AssertNil(t, err)
+			AssertEqual(t, got, tt.want)
+			AssertEqual(t, slice[:4], []int{1, 2, 3, 0})
+		})
+	}
+
+	t.Run("rejects out of range indices", func(t *testing.T) {
+		_, err := InsertAt([]int{1}, 2, 9)
+		AssertNotNil(t, err)
+		AssertEqual(t, err.Error(), "index 2 out of range [0, 1]")
+
+		_, err = InsertAt([]int{1}, -1, 9)
+		AssertNotNil(t, err)
+	})
+}
+
+func TestRemoveAt(t *testing.T) {
+	slice := []int{1, 2, 3}
+
+	got, err := RemoveAt(slice, 1)
+	AssertNil(t, err)
+	AssertEqual(t, got, []int{1, 3})
+	AssertEqual(t, slice, []int{1, 2, 3})
+
+	got, err = RemoveAt(slice, 2)
+	AssertNil(t, err)
+	AssertEqual(t, got, []int{1, 2})
+
+	_, err = RemoveAt(slice, 3)
+	AssertNotNil(t, err)
+	AssertEqual(t, err.Error(), "index 3 out of range [0, 3)")
+
+	_, err = RemoveAt([]int{}, 0)
+	AssertNotNil(t, err)
+}
+
+func TestDeleteFirst(t *testing.T) {
+	slice := []string{"a", "b", "a"}
+
+	got, ok := DeleteFirst(slice, "a")
+	AssertTrue(t, ok)
+	AssertEqual(t, got, []string{"b", "a"})
+	AssertEqual(t, slice, []string{"a", "b", "a"})
+
+	got, ok = DeleteFirst(slice, "z")
+	AssertFalse(t, ok)
+	AssertEqual(t, got, slice)
+}
+
+func TestAssociate(t *testing.T) {
+	pairs := []Pair[string, int]{NewPair("a", 1), NewPair("b", 2), NewPair("a", 3)}
+	AssertEqual(t, Associate(pairs), map[string]int{"a": 3, "b": 2})
+	AssertEqual(t, Associate([]Pair[string, int]{}), map[string]int{})
+}
+
+func TestRangeInt(t *testing.T) {
+	tests := []struct {
+		name  string
+		start int
+		end   int
+		step  int
+		want  []int
+	}{
+		{name: "ascending", start: 0, end: 5, step: 1, want: []int{0, 1, 2, 3, 4}},
+		{name: "stride", start: 0, end: 10, step: 3, want: []int{0, 3, 6, 9}},
+		{name: "descending", start: 5, end: 0, step: -2, want: []int{5, 3, 1}},
+		{name: "empty", start: 3, end: 3, step: 1, want: []int{}},
+		{name: "wrong direction", start: 0, end: 5, step: -1, want: []int{}},
+		{name: "near max", start: math.MaxInt - 2, end: math.MaxInt, step: 5, want: []int{math.MaxInt - 2}},
+		{name: "near min", start: math.MinInt + 2, end: math.MinInt, step: -5, want: []int{math.MinInt + 2}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, RangeInt(tt.start, tt.end, tt.step), tt.want)
+		})
+	}
+
+	t.Run("panics on zero step", func(t *testing.T) {
+		AssertPanicsWith(t, func() { RangeInt(0, 5, 0) }, "range step must not be zero")
+	})
+}
+
+func TestRepeat(t *testing.T) {
+	AssertEqual(t, Repeat("ab", 3), []string{"ab", "ab", "ab"})
+	AssertEqual(t, Repeat(0, 0), []int{})
+	AssertPanicsWith(t, func() { Repeat(1, -1) }, "repeat count must not be negative, got -1")
+}
+
+func TestFill(t *testing.T) {
+	slice := make([]int, 3)
+	Fill(slice, 7)
+	AssertEqual(t, slice, []int{7, 7, 7})
+
+	AssertNotPanics(t, func() { Fill(nil, 7) })
+}
+
+func TestProduct(t *testing.T) {
+	got := Product([]int{1, 2}, []string{"x", "y"})
+	AssertEqual(t, got, []Pair[int, string]{{1, "x"}, {1, "y"}, {2, "x"}, {2, "y"}})
+
+	AssertEqual(t, len(Product([]int{}, []string{"x"})), 0)
+}
+
+func TestProductN(t *testing.T) {
+	tests := []struct {
+		name   string
+		slices [][]string
+		want   [][]string
+	}{
+		{
+			name:   "three dimensions",
+			slices: [][]string{{"linux", "darwin"}, {"amd64", "arm64"}, {"go1.24"}},
+			want: [][]string{
+				{"linux", "amd64", "go1.24"},
+				{"linux", "arm64", "go1.24"},
+				{"darwin", "amd64", "go1.24"},
+				{"darwin", "arm64", "go1.24"},
+			},
+		},
+		{name: "single slice", slices: [][]string{{"a", "b"}}, want: [][]string{{"a"}, {"b"}}},
+		{name: "empty slice", slices: [][]string{{"a"}, {}}, want: [][]string{}},
+		{name: "no slices", slices: nil, want: [][]string{{}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, ProductN(tt.slices...), tt.want)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package pocket
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// Lap is a named interval recorded by a Stopwatch.
+type Lap struct {
+	Name string
+	// Duration is the time since the previous lap (or the start).
+	Duration time.Duration
+	// Elapsed is the time since the start.
+	Elapsed time.Duration
+}
+
+// Stopwatch measures named phases of an operation, e.g. the parse, query and render steps of a request.
+// It is safe for concurrent use.
+type Stopwatch struct {
+	mu    sync.Mutex
+	clock Clock
+	start time.Time
+	last  time.Time
+	laps  []Lap
+	onLap func(Lap)
+}
+
+// NewStopwatch returns a running Stopwatch. If onLap is not nil, it is called with every recorded lap,
+// e.g. to log it or feed a metrics histogram.
+func NewStopwatch(onLap func(Lap)) *Stopwatch {
+	return NewStopwatchWithClock(RealClock{}, onLap)
+}
+
+// NewStopwatchWithClock works like NewStopwatch, reading the time from clock.
+func NewStopwatchWithClock(clock Clock, onLap func(Lap)) *Stopwatch {
+	now := clock.Now()
+	return &Stopwatch{clock: clock, start: now, last: now, onLap: onLap}
+}
+
+// Lap records the time since the previous lap under the given name and returns it.
+func (s *Stopwatch) Lap(name string) Lap {
+	s.mu.Lock()
+	now := s.clock.Now()
+	lap := Lap{Name: name, Duration: now.Sub(s.last), Elapsed: now.Sub(s.start)}
+	s.last = now
+	s.laps = append(s.laps, lap)
+	s.mu.Unlock()
+
+	if s.onLap != nil {
+		s.onLap(lap)
+	}
+	return lap
+}
+
+// Laps returns the recorded laps, in order.
+func (s *Stopwatch) Laps() []Lap {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Lap(nil), s.laps...)
+}
+
+// Elapsed returns the time since the start.
+func (s *Stopwatch) Elapsed() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.clock.Now().Sub(s.start)
+}
+
+// Reset discards the laps and restarts the Stopwatch.
+func (s *Stopwatch) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.start = s.clock.Now()
+	s.last = s.start
+	s.laps = nil
+}
+
+// String summarizes the laps, e.g. "parse=2ms query=40ms render=5ms total=47ms".
+func (s *Stopwatch) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var sb strings.Builder
+	for _, lap := range s.laps {
+		sb.WriteString(lap.Name + "=" + lap.Duration.String() + " ")
+	}
+	sb.WriteString("total=" + s.last.Sub(s.start).String())
+	return sb.String()
+}
+
+// TimeFunc runs fn and returns how long it took.
+// If report is not nil, it is also called with the name and the duration, e.g. to log it.
+func TimeFunc(name string, fn func(), report func(name string, d time.Duration)) time.Duration {
+	return TimeFuncWithClock(RealClock{}, name, fn, report)
+}
+
+// TimeFuncWithClock works like TimeFunc, reading the time from clock.
+func TimeFuncWithClock(clock Clock, name string, fn func(), report func(name string, d time.Duration)) time.Duration {
+	start := clock.Now()
+	fn()
+	d := clock.Now().Sub(start)
+
+	if report != nil {
+		report(name, d)
+	}
+	return d
+}
@@ -0,0 +1,69 @@
+package pocket
+
+import (
+	"testing"
+	"time"
+)
+
+func TestStopwatch(t *testing.T) {
+	clock := NewFakeClock(epoch)
+	var reported []string
+	sw := NewStopwatchWithClock(clock, func(l Lap) { reported = append(reported, l.Name) })
+
+	clock.Advance(20 * time.Millisecond)
+	parse := sw.Lap("parse")
+	AssertEqual(t, parse, Lap{Name: "parse", Duration: 20 * time.Millisecond, Elapsed: 20 * time.Millisecond})
+
+	clock.Advance(50 * time.Millisecond)
+	query := sw.Lap("query")
+	AssertEqual(t, query, Lap{Name: "query", Duration: 50 * time.Millisecond, Elapsed: 70 * time.Millisecond})
+
+	AssertEqual(t, sw.Laps(), []Lap{parse, query})
+	AssertEqual(t, reported, []string{"parse", "query"})
+	AssertEqual(t, sw.String(), "parse=20ms query=50ms total=70ms")
+
+	clock.Advance(5 * time.Millisecond)
+	AssertEqual(t, sw.Elapsed(), 75*time.Millisecond)
+
+	t.Run("reset", func(t *testing.T) {
+		sw.Reset()
+		AssertEqual(t, len(sw.Laps()), 0)
+		AssertEqual(t, sw.Elapsed(), time.Duration(0))
+		AssertEqual(t, sw.String(), "total=0s")
+
+		clock.Advance(time.Second)
+		AssertEqual(t, sw.Lap("again").Duration, time.Second)
+	})
+
+	t.Run("nil callback", func(t *testing.T) {
+		sw := NewStopwatch(nil)
+		AssertNotPanics(t, func() { sw.Lap("x") })
+	})
+}
+
+func TestTimeFunc(t *testing.T) {
+	var name string
+	var reported time.Duration
+
+	d := TimeFunc("sleep", func() { time.Sleep(5 * time.Millisecond) }, func(n string, d time.Duration) {
+		name, reported = n, d
+	})
+
+	AssertGreaterOrEqual(t, d, 5*time.Millisecond)
+	AssertEqual(t, reported, d)
+	AssertEqual(t, name, "sleep")
+
+	AssertNotPanics(t, func() { TimeFunc("noop", func() {}, nil) })
+}
+
+func TestTimeFuncWithClock(t *testing.T) {
+	clock := NewFakeClock(epoch)
+	var reported time.Duration
+
+	d := TimeFuncWithClock(clock, "step", func() { clock.Advance(3 * time.Second) }, func(_ string, d time.Duration) {
+		reported = d
+	})
+
+	AssertEqual(t, d, 3*time.Second)
+	AssertEqual(t, reported, d)
+}
@@ -5,6 +5,15 @@ import (
 	"crypto/sha256"
 	"crypto/subtle"
 	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"math/big"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
 )
 
 // SafeCompare performs a constant-time comparison of two strings to protect against timing attacks.
@@ -16,11 +25,473 @@ func SafeCompare(token1, token2 string) bool {
 }
 
 // GenerateString generates a random string of the specified length.
-// If for any reason `rand.Read` fails, this function will panic!
+// If for any reason `rand.Read` fails, or the length is not positive, this function will panic!
+// Use TryGenerateString to handle those errors instead.
 func GenerateString(len int) string {
+	s, err := TryGenerateString(len)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// TryGenerateString generates a random string of the specified length.
+// Returns an error if the length is not positive or if `rand.Read` fails.
+func TryGenerateString(len int) (string, error) {
+	if len <= 0 {
+		return "", fmt.Errorf("length must be positive, got %d", len)
+	}
+
 	bytes := make([]byte, len)
 	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("cannot read random bytes: %w", err)
+	}
+	return base64.URLEncoding.EncodeToString(bytes), nil
+}
+
+// GenerateHex generates a random hexadecimal string of n characters.
+// If for any reason `rand.Read` fails, or n is not positive, this function will panic!
+func GenerateHex(n int) string {
+	s, err := TryGenerateHex(n)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// TryGenerateHex generates a random hexadecimal string of n characters.
+// Returns an error if n is not positive or if `rand.Read` fails.
+func TryGenerateHex(n int) (string, error) {
+	if n <= 0 {
+		return "", fmt.Errorf("length must be positive, got %d", n)
+	}
+
+	bytes := make([]byte, (n+1)/2)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("cannot read random bytes: %w", err)
+	}
+	return hex.EncodeToString(bytes)[:n], nil
+}
+
+// GenerateDigits generates a random string of n decimal digits, e.g. for one-time codes.
+// Every digit is uniformly distributed. Leading zeros are kept, so the result is always n characters long.
+// If for any reason `rand.Read` fails, or n is not positive, this function will panic!
+func GenerateDigits(n int) string {
+	s, err := TryGenerateDigits(n)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// TryGenerateDigits generates a random string of n decimal digits, e.g. for one-time codes.
+// Returns an error if n is not positive or if `rand.Read` fails.
+func TryGenerateDigits(n int) (string, error) {
+	if n <= 0 {
+		return "", fmt.Errorf("length must be positive, got %d", n)
+	}
+
+	digits := make([]byte, 0, n)
+	buf := make([]byte, n)
+	for len(digits) < n {
+		if _, err := rand.Read(buf); err != nil {
+			return "", fmt.Errorf("cannot read random bytes: %w", err)
+		}
+		for _, b := range buf {
+			// Reject 250-255 so that b%10 is uniformly distributed.
+			if b < 250 && len(digits) < n {
+				digits = append(digits, '0'+b%10)
+			}
+		}
+	}
+	return string(digits), nil
+}
+
+const base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+// tokenChecksumLen is the number of base62 characters needed to hold a CRC32 checksum.
+const tokenChecksumLen = 6
+
+// GenerateToken generates a prefixed API key like "sk_live_6fz2...", following the GitHub/Stripe pattern.
+// The random part holds entropyBytes of crypto/rand entropy and ends with a CRC32 checksum,
+// so leaked keys can be detected by scanners and typos rejected without a database lookup (see ValidateTokenFormat).
+// If for any reason `rand.Read` fails, or the arguments are invalid, this function will panic!
+func GenerateToken(prefix string, entropyBytes int) string {
+	token, err := TryGenerateToken(prefix, entropyBytes)
+	if err != nil {
 		panic(err)
 	}
-	return base64.URLEncoding.EncodeToString(bytes)
+	return token
+}
+
+// TryGenerateToken is like GenerateToken but returns an error instead of panicking.
+// The prefix must be non-empty and made only of ASCII letters, digits, and underscores.
+func TryGenerateToken(prefix string, entropyBytes int) (string, error) {
+	if prefix == "" || strings.Trim(prefix, base62Alphabet+"_") != "" {
+		return "", fmt.Errorf("invalid token prefix %q: use ASCII letters, digits, and underscores", prefix)
+	}
+	if entropyBytes <= 0 {
+		return "", fmt.Errorf("entropy bytes must be positive, got %d", entropyBytes)
+	}
+
+	bytes := make([]byte, entropyBytes)
+	if _, err := rand.Read(bytes); err != nil {
+		return "", fmt.Errorf("cannot read random bytes: %w", err)
+	}
+
+	payload := encodeBase62(new(big.Int).SetBytes(bytes), base62Width(entropyBytes))
+	return prefix + "_" + payload + tokenChecksum(prefix, payload), nil
+}
+
+// ValidateTokenFormat checks that the token was produced by GenerateToken: a prefix, an underscore,
+// and a base62 payload ending with a valid checksum. It does not check that the token exists or is active.
+func ValidateTokenFormat(token string) error {
+	i := strings.LastIndex(token, "_")
+	if i <= 0 {
+		return fmt.Errorf("invalid token format: missing prefix")
+	}
+
+	prefix, rest := token[:i], token[i+1:]
+	if len(rest) <= tokenChecksumLen {
+		return fmt.Errorf("invalid token format: too short")
+	}
+	if strings.Trim(rest, base62Alphabet) != "" {
+		return fmt.Errorf("invalid token format: unexpected characters")
+	}
+
+	payload, checksum := rest[:len(rest)-tokenChecksumLen], rest[len(rest)-tokenChecksumLen:]
+	if !SafeCompare(checksum, tokenChecksum(prefix, payload)) {
+		return fmt.Errorf("invalid token format: checksum mismatch")
+	}
+	return nil
+}
+
+func tokenChecksum(prefix, payload string) string {
+	sum := crc32.ChecksumIEEE([]byte(prefix + "_" + payload))
+	return encodeBase62(new(big.Int).SetUint64(uint64(sum)), tokenChecksumLen)
+}
+
+// base62Width returns the number of base62 characters needed to hold n bytes.
+func base62Width(n int) int {
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(n*8))
+	width := 0
+	for capacity := big.NewInt(1); capacity.Cmp(limit) < 0; width++ {
+		capacity.Mul(capacity, big.NewInt(62))
+	}
+	return width
+}
+
+// encodeBase62 encodes n in base62, left-padded with zeros to width characters.
+func encodeBase62(n *big.Int, width int) string {
+	out := make([]byte, width)
+	base := big.NewInt(62)
+	mod := new(big.Int)
+	n = new(big.Int).Set(n)
+	for i := width - 1; i >= 0; i-- {
+		n.DivMod(n, base, mod)
+		out[i] = base62Alphabet[mod.Int64()]
+	}
+	return string(out)
+}
+
+// Truncate shortens s to at most max runes, never splitting a multi-byte character.
+// When s is truncated, the suffix (e.g. "...") is appended and counts toward max.
+// If max is smaller than the suffix, the suffix is dropped.
+//
+// e.g., Truncate("héllo wörld", 8, "...") → "héllo..."
+// e.g., Truncate("héllo", 8, "...") → "héllo"
+func Truncate(s string, max int, suffix string) string {
+	if max <= 0 {
+		return ""
+	}
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+
+	suffixLen := utf8.RuneCountInString(suffix)
+	if suffixLen > max {
+		suffix = ""
+		suffixLen = 0
+	}
+
+	keep := max - suffixLen
+	for i := range s {
+		if keep == 0 {
+			return s[:i] + suffix
+		}
+		keep--
+	}
+	return s + suffix
+}
+
+// ToSnakeCase converts s to snake_case, e.g. "HTTPServerID" → "http_server_id".
+// Words are split on non-alphanumeric characters, on lower-to-upper transitions, and at the end of acronyms.
+// Digits stay attached to the preceding word, e.g. "SHA256Sum" → "sha256_sum".
+func ToSnakeCase(s string) string {
+	return strings.ToLower(strings.Join(splitWords(s), "_"))
+}
+
+// ToKebabCase converts s to kebab-case, e.g. "HTTPServerID" → "http-server-id".
+func ToKebabCase(s string) string {
+	return strings.ToLower(strings.Join(splitWords(s), "-"))
+}
+
+// ToCamelCase converts s to camelCase, e.g. "http_server_id" → "httpServerId".
+func ToCamelCase(s string) string {
+	words := splitWords(s)
+	for i, w := range words {
+		if i == 0 {
+			words[i] = strings.ToLower(w)
+		} else {
+			words[i] = capitalize(w)
+		}
+	}
+	return strings.Join(words, "")
+}
+
+// ToPascalCase converts s to PascalCase, e.g. "http_server_id" → "HttpServerId".
+func ToPascalCase(s string) string {
+	words := splitWords(s)
+	for i, w := range words {
+		words[i] = capitalize(w)
+	}
+	return strings.Join(words, "")
+}
+
+func capitalize(word string) string {
+	r, size := utf8.DecodeRuneInString(word)
+	return string(unicode.ToUpper(r)) + strings.ToLower(word[size:])
+}
+
+// splitWords splits s into words for case conversion.
+func splitWords(s string) []string {
+	var words []string
+	runes := []rune(s)
+	start := -1
+
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if start >= 0 {
+				words = append(words, string(runes[start:i]))
+				start = -1
+			}
+			continue
+		}
+
+		if start < 0 {
+			start = i
+			continue
+		}
+
+		prev := runes[i-1]
+		lowerToUpper := unicode.IsUpper(r) && (unicode.IsLower(prev) || unicode.IsDigit(prev))
+		acronymEnd := unicode.IsUpper(r) && unicode.IsUpper(prev) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+		if lowerToUpper || acronymEnd {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+
+	if start >= 0 {
+		words = append(words, string(runes[start:]))
+	}
+	return words
+}
+
+// Levenshtein returns the edit distance between a and b:
+// the minimum number of single-rune insertions, deletions or substitutions needed to turn one into the other.
+func Levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(rb)]
+}
+
+// ClosestMatch returns the candidate with the smallest Levenshtein distance to input,
+// as long as that distance is at most maxDist. Ties go to the earliest candidate.
+// The boolean is false if no candidate is close enough.
+// Useful for "did you mean ...?" suggestions.
+func ClosestMatch(input string, candidates []string, maxDist int) (string, bool) {
+	best, bestDist := "", maxDist+1
+	for _, c := range candidates {
+		if d := Levenshtein(input, c); d < bestDist {
+			best, bestDist = c, d
+		}
+	}
+	return best, bestDist <= maxDist
+}
+
+// diacriticBases maps each base letter to the precomposed Latin letters that decompose into it plus combining marks.
+var diacriticBases = map[rune]string{
+	'A': "ÀÁÂÃÄÅĀĂĄǍǞǠǺȀȂȦȺḀẠẢẤẦẨẪẬẮẰẲẴẶ",
+	'a': "àáâãäåāăąǎǟǡǻȁȃȧḁạảấầẩẫậắằẳẵặ",
+	'C': "ÇĆĈĊČḈ",
+	'c': "çćĉċčḉ",
+	'D': "ĎḊḌḎḐḒ",
+	'd': "ďḋḍḏḑḓ",
+	'E': "ÈÉÊËĒĔĖĘĚȄȆȨḔḖḘḚḜẸẺẼẾỀỂỄỆ",
+	'e': "èéêëēĕėęěȅȇȩḕḗḙḛḝẹẻẽếềểễệ",
+	'G': "ĜĞĠĢǦǴḠ",
+	'g': "ĝğġģǧǵḡ",
+	'H': "ĤȞḢḤḦḨḪ",
+	'h': "ĥȟḣḥḧḩḫẖ",
+	'I': "ÌÍÎÏĨĪĬĮİǏȈȊḬḮỈỊ",
+	'i': "ìíîïĩīĭįǐȉȋḭḯỉị",
+	'J': "Ĵ",
+	'j': "ĵǰ",
+	'K': "ĶǨḰḲḴ",
+	'k': "ķǩḱḳḵ",
+	'L': "ĹĻĽḶḸḺḼ",
+	'l': "ĺļľḷḹḻḽ",
+	'N': "ÑŃŅŇǸṄṆṈṊ",
+	'n': "ñńņňǹṅṇṉṋ",
+	'O': "ÒÓÔÕÖŌŎŐƠǑǪǬȌȎȪȬȮȰṌṎṐṒỌỎỐỒỔỖỘỚỜỞỠỢ",
+	'o': "òóôõöōŏőơǒǫǭȍȏȫȭȯȱṍṏṑṓọỏốồổỗộớờởỡợ",
+	'R': "ŔŖŘȐȒṘṚṜṞ",
+	'r': "ŕŗřȑȓṙṛṝṟ",
+	'S': "ŚŜŞŠȘṠṢṤṦṨ",
+	's': "śŝşšșṡṣṥṧṩ",
+	'T': "ŢŤȚṪṬṮṰ",
+	't': "ţťțṫṭṯṱẗ",
+	'U': "ÙÚÛÜŨŪŬŮŰŲƯǓǕǗǙǛȔȖṲṴṶṸṺỤỦỨỪỬỮỰ",
+	'u': "ùúûüũūŭůűųưǔǖǘǚǜȕȗṳṵṷṹṻụủứừửữự",
+	'W': "ŴẀẂẄẆẈ",
+	'w': "ŵẁẃẅẇẉẘ",
+	'Y': "ÝŶŸȲẎỲỴỶỸ",
+	'y': "ýÿŷȳẏẙỳỵỷỹ",
+	'Z': "ŹŻŽẐẒẔ",
+	'z': "źżžẑẓẕ",
+}
+
+var diacritics = func() map[rune]rune {
+	m := make(map[rune]rune)
+	for base, variants := range diacriticBases {
+		for _, r := range variants {
+			m[r] = base
+		}
+	}
+	return m
+}()
+
+// NormalizeNFC returns s in Unicode Normalization Form C, composing letters and combining marks
+// into single code points where possible, so "e\u0301" and "é" compare equal.
+func NormalizeNFC(s string) string {
+	return norm.NFC.String(s)
+}
+
+// NormalizeNFKC returns s in Unicode Normalization Form KC, which also folds compatibility characters
+// such as ligatures, full-width forms and superscripts, e.g. "ﬁ" → "fi" and "Ａ" → "A".
+// It loses formatting distinctions, so use it for matching and searching rather than for storage.
+func NormalizeNFKC(s string) string {
+	return norm.NFKC.String(s)
+}
+
+// RemoveDiacritics strips accents and other diacritical marks from Latin letters, e.g. "Crème Brûlée" → "Creme Brulee".
+// It handles both precomposed letters and letters followed by combining marks.
+// Letters that are distinct rather than accented (like "ß", "ø" or "ł") are left untouched.
+func RemoveDiacritics(s string) string {
+	var sb strings.Builder
+	sb.Grow(len(s))
+
+	for _, r := range s {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+		if base, ok := diacritics[r]; ok {
+			r = base
+		}
+		sb.WriteRune(r)
+	}
+
+	return sb.String()
+}
+
+// WordWrap wraps s so that no line is longer than width runes, breaking at whitespace.
+// Existing line breaks are kept, and words longer than width are placed on their own line rather than split.
+// A non-positive width returns s unchanged.
+func WordWrap(s string, width int) string {
+	if width <= 0 {
+		return s
+	}
+
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		var sb strings.Builder
+		lineLen := 0
+		for _, word := range strings.Fields(line) {
+			wordLen := utf8.RuneCountInString(word)
+			if lineLen > 0 && lineLen+1+wordLen > width {
+				sb.WriteByte('\n')
+				lineLen = 0
+			}
+			if lineLen > 0 {
+				sb.WriteByte(' ')
+				lineLen++
+			}
+			sb.WriteString(word)
+			lineLen += wordLen
+		}
+		lines[i] = sb.String()
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Indent adds prefix to the beginning of every line in s that is not blank.
+func Indent(s, prefix string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		if strings.TrimSpace(line) != "" {
+			lines[i] = prefix + line
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Dedent removes the leading whitespace common to every non-blank line in s,
+// so indented raw string literals can be written in line with the surrounding code.
+// Blank lines are emptied. Tabs and spaces are not considered equal.
+func Dedent(s string) string {
+	lines := strings.Split(s, "\n")
+
+	margin, found := "", false
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		indent := line[:len(line)-len(strings.TrimLeftFunc(line, unicode.IsSpace))]
+		if !found {
+			margin, found = indent, true
+			continue
+		}
+		for !strings.HasPrefix(indent, margin) {
+			_, size := utf8.DecodeLastRuneInString(margin)
+			margin = margin[:len(margin)-size]
+		}
+	}
+
+	for i, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			lines[i] = ""
+		} else {
+			lines[i] = line[len(margin):]
+		}
+	}
+	return strings.Join(lines, "\n")
 }
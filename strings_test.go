@@ -1,6 +1,7 @@
 package pocket
 
 import (
+	"math/big"
 	"strings"
 	"testing"
 )
@@ -97,3 +98,363 @@ func TestGenerateString(t *testing.T) {
 		AssertEqual(t, len(s1) < len(s2), true)
 	})
 }
+
+func TestTryGenerateString(t *testing.T) {
+	t.Run("generates string", func(t *testing.T) {
+		t.Parallel()
+		s, err := TryGenerateString(32)
+		AssertNil(t, err)
+		AssertEqual(t, len(s) > 32, true)
+	})
+
+	t.Run("rejects non-positive length", func(t *testing.T) {
+		t.Parallel()
+		_, err := TryGenerateString(0)
+		AssertNotNil(t, err)
+		_, err = TryGenerateString(-1)
+		AssertNotNil(t, err)
+	})
+
+	t.Run("GenerateString panics on invalid length", func(t *testing.T) {
+		t.Parallel()
+		AssertPanics(t, func() { GenerateString(0) })
+	})
+}
+
+func TestGenerateHex(t *testing.T) {
+	for _, n := range []int{1, 2, 7, 32} {
+		s := GenerateHex(n)
+		AssertEqual(t, len(s), n)
+		AssertEqual(t, strings.Trim(s, "0123456789abcdef"), "")
+	}
+
+	_, err := TryGenerateHex(0)
+	AssertNotNil(t, err)
+	AssertPanics(t, func() { GenerateHex(-1) })
+}
+
+func TestGenerateDigits(t *testing.T) {
+	t.Run("generates digits only", func(t *testing.T) {
+		t.Parallel()
+		for _, n := range []int{1, 6, 20} {
+			s := GenerateDigits(n)
+			AssertEqual(t, len(s), n)
+			AssertEqual(t, strings.Trim(s, "0123456789"), "")
+		}
+	})
+
+	t.Run("uses every digit", func(t *testing.T) {
+		t.Parallel()
+		s := GenerateDigits(1000)
+		for _, d := range "0123456789" {
+			AssertContains(t, s, string(d))
+		}
+	})
+
+	t.Run("rejects non-positive length", func(t *testing.T) {
+		t.Parallel()
+		_, err := TryGenerateDigits(0)
+		AssertNotNil(t, err)
+		AssertPanics(t, func() { GenerateDigits(0) })
+	})
+}
+
+func TestGenerateToken(t *testing.T) {
+	t.Run("generates valid tokens", func(t *testing.T) {
+		t.Parallel()
+		token := GenerateToken("sk_live", 20)
+		AssertTrue(t, strings.HasPrefix(token, "sk_live_"))
+		AssertEqual(t, len(token), len("sk_live_")+base62Width(20)+tokenChecksumLen)
+		AssertNil(t, ValidateTokenFormat(token))
+	})
+
+	t.Run("generates different tokens", func(t *testing.T) {
+		t.Parallel()
+		AssertNotEqual(t, GenerateToken("ghp", 30), GenerateToken("ghp", 30))
+	})
+
+	t.Run("rejects invalid arguments", func(t *testing.T) {
+		t.Parallel()
+		_, err := TryGenerateToken("", 20)
+		AssertNotNil(t, err)
+		_, err = TryGenerateToken("sk-live", 20)
+		AssertNotNil(t, err)
+		_, err = TryGenerateToken("sk", 0)
+		AssertNotNil(t, err)
+		AssertPanics(t, func() { GenerateToken("sk", -1) })
+	})
+}
+
+func TestValidateTokenFormat(t *testing.T) {
+	token := GenerateToken("sk_test", 16)
+	last := token[len(token)-1]
+	tampered := token[:len(token)-1] + string(base62Alphabet[(strings.IndexByte(base62Alphabet, last)+1)%62])
+
+	tests := []struct {
+		name    string
+		token   string
+		wantErr bool
+	}{
+		{name: "valid", token: token, wantErr: false},
+		{name: "tampered checksum", token: tampered, wantErr: true},
+		{name: "different prefix", token: "sk_live" + token[len("sk_test"):], wantErr: true},
+		{name: "missing prefix", token: token[len("sk_test_"):], wantErr: true},
+		{name: "too short", token: "sk_abc", wantErr: true},
+		{name: "invalid characters", token: "sk_abc-defghijk", wantErr: true},
+		{name: "empty", token: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := ValidateTokenFormat(tt.token)
+			if tt.wantErr {
+				AssertNotNil(t, err)
+			} else {
+				AssertNil(t, err)
+			}
+		})
+	}
+}
+
+func TestEncodeBase62(t *testing.T) {
+	AssertEqual(t, encodeBase62(big.NewInt(0), 3), "000")
+	AssertEqual(t, encodeBase62(big.NewInt(61), 2), "0z")
+	AssertEqual(t, encodeBase62(big.NewInt(62), 2), "10")
+	AssertEqual(t, base62Width(4), 6)
+}
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name   string
+		s      string
+		max    int
+		suffix string
+		want   string
+	}{
+		{name: "shorter than max", s: "hello", max: 10, suffix: "...", want: "hello"},
+		{name: "exactly max", s: "hello", max: 5, suffix: "...", want: "hello"},
+		{name: "truncated with suffix", s: "hello world", max: 8, suffix: "...", want: "hello..."},
+		{name: "multi-byte characters", s: "héllo wörld", max: 8, suffix: "...", want: "héllo..."},
+		{name: "emoji", s: "🙂🙃🙂🙃", max: 3, suffix: "…", want: "🙂🙃…"},
+		{name: "no suffix", s: "hello world", max: 5, suffix: "", want: "hello"},
+		{name: "max smaller than suffix", s: "hello world", max: 2, suffix: "...", want: "he"},
+		{name: "zero max", s: "hello", max: 0, suffix: "...", want: ""},
+		{name: "negative max", s: "hello", max: -1, suffix: "...", want: ""},
+		{name: "empty string", s: "", max: 3, suffix: "...", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, Truncate(tt.s, tt.max, tt.suffix), tt.want)
+		})
+	}
+}
+
+func TestCaseConversion(t *testing.T) {
+	tests := []struct {
+		input  string
+		snake  string
+		kebab  string
+		camel  string
+		pascal string
+	}{
+		{input: "hello world", snake: "hello_world", kebab: "hello-world", camel: "helloWorld", pascal: "HelloWorld"},
+		{input: "helloWorld", snake: "hello_world", kebab: "hello-world", camel: "helloWorld", pascal: "HelloWorld"},
+		{input: "HelloWorld", snake: "hello_world", kebab: "hello-world", camel: "helloWorld", pascal: "HelloWorld"},
+		{input: "hello_world", snake: "hello_world", kebab: "hello-world", camel: "helloWorld", pascal: "HelloWorld"},
+		{input: "hello-world", snake: "hello_world", kebab: "hello-world", camel: "helloWorld", pascal: "HelloWorld"},
+		{input: "HTTPServer", snake: "http_server", kebab: "http-server", camel: "httpServer", pascal: "HttpServer"},
+		{input: "userID", snake: "user_id", kebab: "user-id", camel: "userId", pascal: "UserId"},
+		{input: "APIKey", snake: "api_key", kebab: "api-key", camel: "apiKey", pascal: "ApiKey"},
+		{input: "SHA256Sum", snake: "sha256_sum", kebab: "sha256-sum", camel: "sha256Sum", pascal: "Sha256Sum"},
+		{input: "version2", snake: "version2", kebab: "version2", camel: "version2", pascal: "Version2"},
+		{input: "LOG_LEVEL", snake: "log_level", kebab: "log-level", camel: "logLevel", pascal: "LogLevel"},
+		{input: "  leading and trailing  ", snake: "leading_and_trailing", kebab: "leading-and-trailing", camel: "leadingAndTrailing", pascal: "LeadingAndTrailing"},
+		{input: "", snake: "", kebab: "", camel: "", pascal: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, ToSnakeCase(tt.input), tt.snake)
+			AssertEqual(t, ToKebabCase(tt.input), tt.kebab)
+			AssertEqual(t, ToCamelCase(tt.input), tt.camel)
+			AssertEqual(t, ToPascalCase(tt.input), tt.pascal)
+		})
+	}
+}
+
+func TestLevenshtein(t *testing.T) {
+	tests := []struct {
+		a    string
+		b    string
+		want int
+	}{
+		{a: "", b: "", want: 0},
+		{a: "abc", b: "", want: 3},
+		{a: "", b: "abc", want: 3},
+		{a: "kitten", b: "sitting", want: 3},
+		{a: "flaw", b: "lawn", want: 2},
+		{a: "same", b: "same", want: 0},
+		{a: "año", b: "ano", want: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.a+"_"+tt.b, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, Levenshtein(tt.a, tt.b), tt.want)
+			AssertEqual(t, Levenshtein(tt.b, tt.a), tt.want)
+		})
+	}
+}
+
+func TestClosestMatch(t *testing.T) {
+	commands := []string{"build", "test", "vet", "fmt"}
+
+	t.Run("finds closest candidate", func(t *testing.T) {
+		match, ok := ClosestMatch("tset", commands, 2)
+		AssertTrue(t, ok)
+		AssertEqual(t, match, "test")
+	})
+
+	t.Run("prefers earliest on ties", func(t *testing.T) {
+		match, ok := ClosestMatch("vmt", commands, 1)
+		AssertTrue(t, ok)
+		AssertEqual(t, match, "vet")
+	})
+
+	t.Run("returns false when nothing is close enough", func(t *testing.T) {
+		match, ok := ClosestMatch("deploy", commands, 2)
+		AssertFalse(t, ok)
+		AssertEqual(t, match, "")
+	})
+
+	t.Run("returns false for no candidates", func(t *testing.T) {
+		_, ok := ClosestMatch("test", nil, 2)
+		AssertFalse(t, ok)
+	})
+}
+
+func TestNormalizeNFC(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "e\u0301", want: "\u00e9"},
+		{input: "Cafe\u0301", want: "Caf\u00e9"},
+		{input: "\u00e9", want: "\u00e9"},
+		{input: "\ufb01", want: "\ufb01"},
+		{input: "plain ascii", want: "plain ascii"},
+		{input: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, NormalizeNFC(tt.input), tt.want)
+		})
+	}
+}
+
+func TestNormalizeNFKC(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "e\u0301", want: "\u00e9"},
+		{input: "\ufb01le", want: "file"},
+		{input: "\uff21\uff22\uff23", want: "ABC"},
+		{input: "x\u00b2", want: "x2"},
+		{input: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, NormalizeNFKC(tt.input), tt.want)
+		})
+	}
+}
+
+func TestRemoveDiacritics(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{input: "Crème Brûlée", want: "Creme Brulee"},
+		{input: "Ñandú", want: "Nandu"},
+		{input: "Ærøskøbing", want: "Ærøskøbing"},
+		{input: "São João", want: "Sao Joao"},
+		{input: "Dvořák", want: "Dvorak"},
+		{input: "Tiếng Việt", want: "Tieng Viet"},
+		{input: "Cafe\u0301", want: "Cafe"},
+		{input: "e\u0301", want: "e"},
+		{input: "Cafe\u0301 cre\u0300me", want: "Cafe creme"},
+		{input: "Café", want: "Cafe"},
+		{input: "straße", want: "straße"},
+		{input: "plain ascii", want: "plain ascii"},
+		{input: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, RemoveDiacritics(tt.input), tt.want)
+		})
+	}
+}
+
+func TestWordWrap(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		width int
+		want  string
+	}{
+		{name: "fits", input: "short line", width: 20, want: "short line"},
+		{name: "wraps", input: "the quick brown fox jumps over the lazy dog", width: 10, want: "the quick\nbrown fox\njumps over\nthe lazy\ndog"},
+		{name: "keeps line breaks", input: "one two\nthree four", width: 3, want: "one\ntwo\nthree\nfour"},
+		{name: "long word", input: "a supercalifragilistic word", width: 5, want: "a\nsupercalifragilistic\nword"},
+		{name: "collapses whitespace", input: "a   b\tc", width: 10, want: "a b c"},
+		{name: "counts runes", input: "añejo ñandú", width: 5, want: "añejo\nñandú"},
+		{name: "non-positive width", input: "a b", width: 0, want: "a b"},
+		{name: "empty", input: "", width: 5, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, WordWrap(tt.input, tt.width), tt.want)
+		})
+	}
+}
+
+func TestIndent(t *testing.T) {
+	AssertEqual(t, Indent("a\nb", "  "), "  a\n  b")
+	AssertEqual(t, Indent("a\n\n  \nb", "> "), "> a\n\n  \n> b")
+	AssertEqual(t, Indent("", "  "), "")
+}
+
+func TestDedent(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{name: "common indent", input: "    a\n    b", want: "a\nb"},
+		{name: "nested indent", input: "  a\n    b\n  c", want: "a\n  b\nc"},
+		{name: "blank lines", input: "\n    a\n  \n    b\n", want: "\na\n\nb\n"},
+		{name: "mixed tabs and spaces", input: "\t a\n\t\tb", want: " a\n\tb"},
+		{name: "no indent", input: "a\n  b", want: "a\n  b"},
+		{name: "empty", input: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			AssertEqual(t, Dedent(tt.input), tt.want)
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package pocket
+
+import "sync"
+
+// SyncMap is a typed wrapper around sync.Map, removing the type assertions at every call site.
+// The zero value is an empty map ready to use. It must not be copied after first use.
+type SyncMap[K comparable, V any] struct {
+	m sync.Map
+}
+
+// Get returns the value for the key, and whether it was present.
+func (m *SyncMap[K, V]) Get(key K) (V, bool) {
+	v, ok := m.m.Load(key)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	// Checked assertions, since a nil stored for an interface-typed V does not assert to V.
+	val, _ := v.(V)
+	return val, true
+}
+
+// Set stores the value for the key.
+func (m *SyncMap[K, V]) Set(key K, value V) {
+	m.m.Store(key, value)
+}
+
+// Delete removes the key.
+func (m *SyncMap[K, V]) Delete(key K) {
+	m.m.Delete(key)
+}
+
+// GetOrSet returns the existing value for the key if present.
+// Otherwise, it stores and returns the given value. The boolean is true if the value was already present.
+func (m *SyncMap[K, V]) GetOrSet(key K, value V) (V, bool) {
+	v, loaded := m.m.LoadOrStore(key, value)
+	val, _ := v.(V)
+	return val, loaded
+}
+
+// GetOrCompute returns the existing value for the key if present.
+// Otherwise, it calls compute and stores its result, unless another goroutine stored a value first,
+// in which case that value is returned. compute may therefore run more than once for the same key
+// under contention, but only one result is ever stored.
+// The boolean is true if the value was already present.
+func (m *SyncMap[K, V]) GetOrCompute(key K, compute func() V) (V, bool) {
+	if v, ok := m.Get(key); ok {
+		return v, true
+	}
+	return m.GetOrSet(key, compute())
+}
+
+// Range calls f for each entry, stopping if it returns false.
+// Like sync.Map.Range, it does not correspond to a consistent snapshot of the map.
+func (m *SyncMap[K, V]) Range(f func(key K, value V) bool) {
+	m.m.Range(func(k, v any) bool {
+		key, _ := k.(K)
+		val, _ := v.(V)
+		return f(key, val)
+	})
+}
+
+// Len returns the number of entries. It walks the whole map, so avoid it on hot paths.
+func (m *SyncMap[K, V]) Len() int {
+	n := 0
+	m.m.Range(func(_, _ any) bool {
+		n++
+		return true
+	})
+	return n
+}
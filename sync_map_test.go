@@ -0,0 +1,119 @@
+package pocket
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestSyncMap(t *testing.T) {
+	t.Run("get, set and delete", func(t *testing.T) {
+		var m SyncMap[string, int]
+
+		_, ok := m.Get("a")
+		AssertFalse(t, ok)
+
+		m.Set("a", 1)
+		v, ok := m.Get("a")
+		AssertTrue(t, ok)
+		AssertEqual(t, v, 1)
+
+		m.Delete("a")
+		_, ok = m.Get("a")
+		AssertFalse(t, ok)
+	})
+
+	t.Run("get or set", func(t *testing.T) {
+		var m SyncMap[string, int]
+
+		v, loaded := m.GetOrSet("a", 1)
+		AssertFalse(t, loaded)
+		AssertEqual(t, v, 1)
+
+		v, loaded = m.GetOrSet("a", 2)
+		AssertTrue(t, loaded)
+		AssertEqual(t, v, 1)
+	})
+
+	t.Run("get or compute", func(t *testing.T) {
+		var m SyncMap[string, []string]
+		calls := 0
+		compute := func() []string {
+			calls++
+			return []string{"computed"}
+		}
+
+		v, loaded := m.GetOrCompute("a", compute)
+		AssertFalse(t, loaded)
+		AssertEqual(t, v, []string{"computed"})
+
+		v, loaded = m.GetOrCompute("a", compute)
+		AssertTrue(t, loaded)
+		AssertEqual(t, v, []string{"computed"})
+		AssertEqual(t, calls, 1)
+	})
+
+	t.Run("range and len", func(t *testing.T) {
+		var m SyncMap[int, int]
+		for i := range 5 {
+			m.Set(i, i*i)
+		}
+		AssertEqual(t, m.Len(), 5)
+
+		sum := 0
+		m.Range(func(_, v int) bool {
+			sum += v
+			return true
+		})
+		AssertEqual(t, sum, 0+1+4+9+16)
+
+		visited := 0
+		m.Range(func(_, _ int) bool {
+			visited++
+			return false
+		})
+		AssertEqual(t, visited, 1)
+	})
+
+	t.Run("nil interface values", func(t *testing.T) {
+		var m SyncMap[any, error]
+		m.Set("a", nil)
+		m.Set(nil, nil)
+
+		v, ok := m.Get("a")
+		AssertTrue(t, ok)
+		AssertNil(t, v)
+
+		v, loaded := m.GetOrSet("a", errors.New("boom"))
+		AssertTrue(t, loaded)
+		AssertNil(t, v)
+
+		seen := 0
+		m.Range(func(_ any, value error) bool {
+			AssertNil(t, value)
+			seen++
+			return true
+		})
+		AssertEqual(t, seen, 2)
+	})
+
+	t.Run("concurrent access stores a single value", func(t *testing.T) {
+		var m SyncMap[string, int64]
+		var counter atomic.Int64
+		var wg sync.WaitGroup
+
+		results := make([]int64, 50)
+		for i := range results {
+			wg.Go(func() {
+				results[i], _ = m.GetOrCompute("key", func() int64 { return counter.Add(1) })
+			})
+		}
+		wg.Wait()
+
+		stored, _ := m.Get("key")
+		for _, r := range results {
+			AssertEqual(t, r, stored)
+		}
+	})
+}
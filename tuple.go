@@ -0,0 +1,99 @@
+package pocket
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Pair holds two values of possibly different types.
+// It marshals to JSON as a two-element array, e.g. ["answer",42].
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// NewPair returns a Pair holding the given values.
+func NewPair[A any, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{First: first, Second: second}
+}
+
+// Unpack returns the values of the pair, e.g. k, v := entry.Unpack().
+func (p Pair[A, B]) Unpack() (A, B) {
+	return p.First, p.Second
+}
+
+// MarshalJSON implements json.Marshaler, encoding the pair as [first, second].
+func (p Pair[A, B]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{p.First, p.Second})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a two-element array.
+func (p *Pair[A, B]) UnmarshalJSON(data []byte) error {
+	elems, err := unmarshalTuple(data, 2)
+	if err != nil {
+		return err
+	}
+	var out Pair[A, B]
+	if err := json.Unmarshal(elems[0], &out.First); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(elems[1], &out.Second); err != nil {
+		return err
+	}
+	*p = out
+	return nil
+}
+
+// Triple holds three values of possibly different types.
+// It marshals to JSON as a three-element array.
+type Triple[A any, B any, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// NewTriple returns a Triple holding the given values.
+func NewTriple[A any, B any, C any](first A, second B, third C) Triple[A, B, C] {
+	return Triple[A, B, C]{First: first, Second: second, Third: third}
+}
+
+// Unpack returns the values of the triple.
+func (t Triple[A, B, C]) Unpack() (A, B, C) {
+	return t.First, t.Second, t.Third
+}
+
+// MarshalJSON implements json.Marshaler, encoding the triple as [first, second, third].
+func (t Triple[A, B, C]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]any{t.First, t.Second, t.Third})
+}
+
+// UnmarshalJSON implements json.Unmarshaler, decoding a three-element array.
+func (t *Triple[A, B, C]) UnmarshalJSON(data []byte) error {
+	elems, err := unmarshalTuple(data, 3)
+	if err != nil {
+		return err
+	}
+	var out Triple[A, B, C]
+	if err := json.Unmarshal(elems[0], &out.First); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(elems[1], &out.Second); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(elems[2], &out.Third); err != nil {
+		return err
+	}
+	*t = out
+	return nil
+}
+
+func unmarshalTuple(data []byte, n int) ([]json.RawMessage, error) {
+	var elems []json.RawMessage
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return nil, err
+	}
+	if len(elems) != n {
+		return nil, fmt.Errorf("expected a JSON array of %d elements, got %d", n, len(elems))
+	}
+	return elems, nil
+}
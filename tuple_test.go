@@ -0,0 +1,67 @@
+package pocket
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewPair(t *testing.T) {
+	p := NewPair("answer", 42)
+	AssertEqual(t, p.First, "answer")
+	AssertEqual(t, p.Second, 42)
+	AssertEqual(t, p, Pair[string, int]{First: "answer", Second: 42})
+
+	name, n := p.Unpack()
+	AssertEqual(t, name, "answer")
+	AssertEqual(t, n, 42)
+}
+
+func TestNewTriple(t *testing.T) {
+	tr := NewTriple("x", 1, true)
+	AssertEqual(t, tr, Triple[string, int, bool]{First: "x", Second: 1, Third: true})
+
+	a, b, c := tr.Unpack()
+	AssertEqual(t, a, "x")
+	AssertEqual(t, b, 1)
+	AssertTrue(t, c)
+}
+
+func TestTupleJSON(t *testing.T) {
+	t.Run("pair round trip", func(t *testing.T) {
+		data, err := json.Marshal(NewPair("answer", 42))
+		AssertNil(t, err)
+		AssertEqual(t, string(data), `["answer",42]`)
+
+		var p Pair[string, int]
+		AssertNil(t, json.Unmarshal(data, &p))
+		AssertEqual(t, p, NewPair("answer", 42))
+	})
+
+	t.Run("triple round trip", func(t *testing.T) {
+		data, err := json.Marshal(NewTriple("x", []int{1, 2}, map[string]bool{"ok": true}))
+		AssertNil(t, err)
+		AssertEqual(t, string(data), `["x",[1,2],{"ok":true}]`)
+
+		var tr Triple[string, []int, map[string]bool]
+		AssertNil(t, json.Unmarshal(data, &tr))
+		AssertEqual(t, tr, NewTriple("x", []int{1, 2}, map[string]bool{"ok": true}))
+	})
+
+	t.Run("nested in structs and slices", func(t *testing.T) {
+		entries := []Pair[string, int]{NewPair("a", 1), NewPair("b", 2)}
+		data, err := json.Marshal(entries)
+		AssertNil(t, err)
+		AssertEqual(t, string(data), `[["a",1],["b",2]]`)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var p Pair[string, int]
+		for _, data := range []string{`["a"]`, `["a",1,2]`, `{"First":"a"}`, `["a","b"]`} {
+			AssertNotNil(t, json.Unmarshal([]byte(data), &p), data)
+		}
+
+		var tr Triple[int, int, int]
+		AssertNotNil(t, json.Unmarshal([]byte(`[1,2]`), &tr))
+		AssertNotNil(t, json.Unmarshal([]byte(`[1,2,"3"]`), &tr))
+	})
+}
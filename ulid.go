@@ -0,0 +1,181 @@
+package pocket
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// crockfordAlphabet is Crockford's Base32 alphabet, which excludes I, L, O, and U to avoid ambiguity.
+const crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ULID is a Universally Unique Lexicographically Sortable Identifier:
+// a 48-bit millisecond timestamp followed by 80 bits of randomness.
+// Its 26-character string form sorts in creation order, which makes it a good fit for database keys.
+// See https://github.com/ulid/spec.
+type ULID [16]byte
+
+var defaultULIDSource = NewULIDSource(RealClock{})
+
+// NewULID returns a new ULID for the current time.
+// ULIDs generated within the same millisecond are monotonically increasing,
+// so they sort in generation order even when their timestamps are equal.
+func NewULID() ULID {
+	return defaultULIDSource.New()
+}
+
+// ULIDSource generates monotonic ULIDs timestamped by its Clock,
+// so code that creates IDs can be tested with a FakeClock. It is safe for concurrent use.
+type ULIDSource struct {
+	clock Clock
+	gen   ulidGenerator
+}
+
+// NewULIDSource returns a ULIDSource that reads the time from clock.
+func NewULIDSource(clock Clock) *ULIDSource {
+	return &ULIDSource{clock: clock}
+}
+
+// New returns a new ULID for the clock's current time, with the same monotonicity guarantees as NewULID.
+func (s *ULIDSource) New() ULID {
+	return s.gen.next(s.clock.Now())
+}
+
+// ParseULID parses the 26-character string form of a ULID.
+// Parsing is case-insensitive and, following Crockford's Base32, accepts I and L as 1 and O as 0.
+func ParseULID(s string) (ULID, error) {
+	if len(s) != 26 {
+		return ULID{}, fmt.Errorf("invalid ULID %q: expected 26 characters, got %d", s, len(s))
+	}
+
+	var hi, lo uint64
+	for i := 0; i < len(s); i++ {
+		v, ok := crockfordValue(s[i])
+		if !ok {
+			return ULID{}, fmt.Errorf("invalid ULID %q: unexpected character %q", s, s[i])
+		}
+		if i == 0 && v > 7 {
+			return ULID{}, fmt.Errorf("invalid ULID %q: value overflows 128 bits", s)
+		}
+		hi = hi<<5 | lo>>59
+		lo = lo<<5 | uint64(v)
+	}
+
+	var u ULID
+	binary.BigEndian.PutUint64(u[:8], hi)
+	binary.BigEndian.PutUint64(u[8:], lo)
+	return u, nil
+}
+
+// String returns the 26-character Crockford Base32 form of the ULID.
+func (u ULID) String() string {
+	hi := binary.BigEndian.Uint64(u[:8])
+	lo := binary.BigEndian.Uint64(u[8:])
+
+	var out [26]byte
+	for i := len(out) - 1; i >= 0; i-- {
+		out[i] = crockfordAlphabet[lo&31]
+		lo = lo>>5 | hi<<59
+		hi >>= 5
+	}
+	return string(out[:])
+}
+
+// Time returns the timestamp encoded in the ULID, with millisecond precision.
+func (u ULID) Time() time.Time {
+	return time.UnixMilli(int64(ulidMillis(u)))
+}
+
+// Compare returns -1, 0, or +1 depending on whether u sorts before, equal to, or after other.
+// The order matches the order of their string forms.
+func (u ULID) Compare(other ULID) int {
+	return bytes.Compare(u[:], other[:])
+}
+
+// MarshalText implements encoding.TextMarshaler using the string form.
+func (u ULID) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler using ParseULID.
+func (u *ULID) UnmarshalText(text []byte) error {
+	parsed, err := ParseULID(string(text))
+	if err != nil {
+		return err
+	}
+	*u = parsed
+	return nil
+}
+
+func ulidMillis(u ULID) uint64 {
+	return uint64(u[0])<<40 | uint64(u[1])<<32 | uint64(u[2])<<24 | uint64(u[3])<<16 | uint64(u[4])<<8 | uint64(u[5])
+}
+
+func crockfordValue(c byte) (byte, bool) {
+	if c >= 'a' && c <= 'z' {
+		c -= 'a' - 'A'
+	}
+	switch c {
+	case 'I', 'L':
+		c = '1'
+	case 'O':
+		c = '0'
+	}
+	if i := strings.IndexByte(crockfordAlphabet, c); i >= 0 {
+		return byte(i), true
+	}
+	return 0, false
+}
+
+// ulidGenerator remembers the last ULID to guarantee monotonicity within the same millisecond.
+type ulidGenerator struct {
+	mu   sync.Mutex
+	last ULID
+}
+
+var errULIDOverflow = errors.New("ULID entropy overflow within the same millisecond")
+
+func (g *ulidGenerator) next(now time.Time) ULID {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	ms := uint64(now.UnixMilli())
+
+	var u ULID
+	if ms <= ulidMillis(g.last) && g.last != (ULID{}) {
+		// Same millisecond (or the clock went backwards): increment the previous entropy.
+		u = g.last
+		if !incrementEntropy(&u) {
+			panic(errULIDOverflow)
+		}
+	} else {
+		u[0] = byte(ms >> 40)
+		u[1] = byte(ms >> 32)
+		u[2] = byte(ms >> 24)
+		u[3] = byte(ms >> 16)
+		u[4] = byte(ms >> 8)
+		u[5] = byte(ms)
+		if _, err := rand.Read(u[6:]); err != nil {
+			panic(err)
+		}
+	}
+
+	g.last = u
+	return u
+}
+
+// incrementEntropy adds one to the 80-bit random part, reporting false on overflow.
+func incrementEntropy(u *ULID) bool {
+	for i := len(u) - 1; i >= 6; i-- {
+		u[i]++
+		if u[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
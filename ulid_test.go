@@ -0,0 +1,129 @@
+package pocket
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewULID(t *testing.T) {
+	t.Run("string form", func(t *testing.T) {
+		t.Parallel()
+		s := NewULID().String()
+		AssertEqual(t, len(s), 26)
+		AssertEqual(t, strings.Trim(s, crockfordAlphabet), "")
+	})
+
+	t.Run("encodes the current time", func(t *testing.T) {
+		t.Parallel()
+		before := time.Now().Truncate(time.Millisecond)
+		u := NewULID()
+		AssertFalse(t, u.Time().Before(before))
+		AssertFalse(t, u.Time().After(time.Now()))
+	})
+
+	t.Run("sorts in generation order", func(t *testing.T) {
+		t.Parallel()
+		ids := make([]string, 1000)
+		for i := range ids {
+			ids[i] = NewULID().String()
+		}
+		AssertSorted(t, ids)
+		AssertEqual(t, len(slices.Compact(ids)), len(ids))
+	})
+}
+
+func TestULIDGenerator(t *testing.T) {
+	now := time.UnixMilli(1_700_000_000_000)
+
+	t.Run("monotonic within the same millisecond", func(t *testing.T) {
+		g := &ulidGenerator{}
+		first := g.next(now)
+		second := g.next(now)
+		AssertEqual(t, first.Compare(second), -1)
+		AssertEqual(t, first.Time(), second.Time())
+	})
+
+	t.Run("monotonic when the clock goes backwards", func(t *testing.T) {
+		g := &ulidGenerator{}
+		first := g.next(now)
+		second := g.next(now.Add(-time.Second))
+		AssertEqual(t, first.Compare(second), -1)
+	})
+
+	t.Run("new millisecond uses new entropy", func(t *testing.T) {
+		g := &ulidGenerator{}
+		first := g.next(now)
+		second := g.next(now.Add(time.Millisecond))
+		AssertEqual(t, second.Time(), now.Add(time.Millisecond))
+		AssertEqual(t, first.Compare(second), -1)
+	})
+
+	t.Run("panics on entropy overflow", func(t *testing.T) {
+		g := &ulidGenerator{}
+		g.next(now)
+		for i := 6; i < 16; i++ {
+			g.last[i] = 0xFF
+		}
+		AssertPanicsWith(t, func() { g.next(now) }, any(errULIDOverflow))
+	})
+}
+
+func TestParseULID(t *testing.T) {
+	u := NewULID()
+
+	t.Run("round trip", func(t *testing.T) {
+		parsed, err := ParseULID(u.String())
+		AssertNil(t, err)
+		AssertEqual(t, parsed, u)
+	})
+
+	t.Run("case insensitive", func(t *testing.T) {
+		parsed, err := ParseULID(strings.ToLower(u.String()))
+		AssertNil(t, err)
+		AssertEqual(t, parsed, u)
+	})
+
+	t.Run("known value", func(t *testing.T) {
+		parsed, err := ParseULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+		AssertNil(t, err)
+		AssertEqual(t, parsed.Time().UnixMilli(), int64(1469922850259))
+		AssertEqual(t, parsed.String(), "01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	})
+
+	t.Run("text marshaling", func(t *testing.T) {
+		text, err := u.MarshalText()
+		AssertNil(t, err)
+		var parsed ULID
+		AssertNil(t, parsed.UnmarshalText(text))
+		AssertEqual(t, parsed, u)
+	})
+
+	invalid := []string{
+		"",
+		"01ARZ3NDEKTSV4RRFFQ69G5FA",
+		"01ARZ3NDEKTSV4RRFFQ69G5FAVX",
+		"01ARZ3NDEKTSV4RRFFQ69G5FAU",
+		"81ARZ3NDEKTSV4RRFFQ69G5FAV",
+	}
+	for _, s := range invalid {
+		t.Run("invalid "+s, func(t *testing.T) {
+			_, err := ParseULID(s)
+			AssertNotNil(t, err)
+		})
+	}
+}
+
+func TestULIDSource(t *testing.T) {
+	clock := NewFakeClock(epoch)
+	src := NewULIDSource(clock)
+
+	first := src.New()
+	AssertTrue(t, first.Time().Equal(epoch))
+
+	clock.Advance(time.Hour)
+	second := src.New()
+	AssertTrue(t, second.Time().Equal(epoch.Add(time.Hour)))
+	AssertEqual(t, first.Compare(second), -1)
+}
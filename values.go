@@ -0,0 +1,52 @@
+package pocket
+
+// Ptr returns a pointer to a copy of v, e.g. for optional struct fields: User{Nickname: pocket.Ptr("ana")}.
+func Ptr[T any](v T) *T {
+	return &v
+}
+
+// Deref returns the value p points to, or the zero value of T if p is nil.
+func Deref[T any](p *T) T {
+	if p == nil {
+		var zero T
+		return zero
+	}
+	return *p
+}
+
+// DerefOr returns the value p points to, or fallback if p is nil.
+func DerefOr[T any](p *T, fallback T) T {
+	if p == nil {
+		return fallback
+	}
+	return *p
+}
+
+// If returns a if cond is true, and b otherwise.
+// Both arguments are evaluated before the call; use IfElse when they are expensive or must not run both.
+func If[T any](cond bool, a, b T) T {
+	if cond {
+		return a
+	}
+	return b
+}
+
+// IfElse calls and returns the result of a if cond is true, and of b otherwise. Only one of them runs.
+func IfElse[T any](cond bool, a, b func() T) T {
+	if cond {
+		return a()
+	}
+	return b()
+}
+
+// Coalesce returns the first of the values that is not the zero value of T, or the zero value if all are.
+// Useful for layered defaults: pocket.Coalesce(flagValue, envValue, "default").
+func Coalesce[T comparable](values ...T) T {
+	var zero T
+	for _, v := range values {
+		if v != zero {
+			return v
+		}
+	}
+	return zero
+}
@@ -0,0 +1,55 @@
+package pocket
+
+import "testing"
+
+func TestPtr(t *testing.T) {
+	p := Ptr(42)
+	AssertEqual(t, *p, 42)
+
+	v := "original"
+	q := Ptr(v)
+	*q = "changed"
+	AssertEqual(t, v, "original")
+}
+
+func TestDeref(t *testing.T) {
+	AssertEqual(t, Deref(Ptr(42)), 42)
+	AssertEqual(t, Deref[int](nil), 0)
+	AssertEqual(t, Deref[string](nil), "")
+}
+
+func TestDerefOr(t *testing.T) {
+	AssertEqual(t, DerefOr(Ptr(42), 7), 42)
+	AssertEqual(t, DerefOr(Ptr(0), 7), 0)
+	AssertEqual(t, DerefOr(nil, 7), 7)
+}
+
+func TestIf(t *testing.T) {
+	AssertEqual(t, If(true, "yes", "no"), "yes")
+	AssertEqual(t, If(false, "yes", "no"), "no")
+}
+
+func TestIfElse(t *testing.T) {
+	calls := 0
+	expensive := func() int {
+		calls++
+		return 1
+	}
+	cheap := func() int { return 2 }
+
+	AssertEqual(t, IfElse(false, expensive, cheap), 2)
+	AssertEqual(t, calls, 0)
+
+	AssertEqual(t, IfElse(true, expensive, cheap), 1)
+	AssertEqual(t, calls, 1)
+}
+
+func TestCoalesce(t *testing.T) {
+	AssertEqual(t, Coalesce("", "env", "default"), "env")
+	AssertEqual(t, Coalesce(0, 0, 3), 3)
+	AssertEqual(t, Coalesce("", ""), "")
+	AssertEqual(t, Coalesce[string](), "")
+
+	var nilPtr *int
+	AssertEqual(t, Coalesce(nilPtr, Ptr(1)) != nil, true)
+}